@@ -1,17 +1,37 @@
 package main
 
 import (
+	"log"
+	"os"
+
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 	"github.com/noppikinatta/ebitenginegamejam2025/scene"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
 	"github.com/noppikinatta/ebitenginegamejam2025/ui"
-	"github.com/noppikinatta/nyuuryoku"
 )
 
 func main() {
-	ebiten.SetWindowSize(1280, 720)
 	ebiten.SetWindowTitle("Ebitengine Game Jam 2025")
 
-	input := ui.Input{Mouse: nyuuryoku.NewMouse()}
-	seq := scene.CreateSequence(&input)
+	loadTranslationPacks()
+
+	// An unavailable settings file gives the default settings.
+	settings, _ := store.LoadSettings()
+	scene.ApplySettings(settings)
+
+	input := ui.NewInput()
+	seq := scene.CreateSequence(input, settings)
 	ebiten.RunGame(seq)
 }
+
+// loadTranslationPacks loads the translation packs in the user directory. There is no directory in browsers.
+func loadTranslationPacks() {
+	dir, err := store.TranslationPackDir()
+	if err != nil {
+		return
+	}
+	for _, report := range lang.LoadPacks(os.DirFS(dir)) {
+		log.Print(report)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"image"
+	"io"
 	"io/fs"
 	"log"
 	"path"
@@ -96,9 +97,16 @@ func loadCSV(filepath string) (map[string]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer f.Close()
 
+	return ParseLangCSV(f)
+}
+
+// ParseLangCSV reads lang templates in the key,template format of the CSVs under asset/lang.
+// A literal \n in a template is turned into a newline.
+func ParseLangCSV(reader io.Reader) (map[string]string, error) {
 	m := make(map[string]string)
-	r := csv.NewReader(f)
+	r := csv.NewReader(reader)
 	r.Comment = '#'
 	r.LazyQuotes = true
 	r.TrimLeadingSpace = true
@@ -19,7 +19,6 @@ import (
 	_ "embed"
 	"errors"
 	"io"
-	"log"
 
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/audio/mp3"
@@ -43,96 +42,94 @@ var context *audio.Context
 
 func init() {
 	context = audio.NewContext(sampleRate)
-	soundCache = map[Sound]*audio.Player{}
 }
 
 type Sound int
 
 const (
-	BGM Sound = iota
+	BGM Sound = iota // BGM is the in-game BGM.
+	BGMTitle
+	BGMVictory
+	BGMDefeat
 	SEExplosion
+	SECardPlayed
+	SEConstruction
+	SEPackOpened
+	SETurnEnded
+	SEBattleLost
+	SEVictory
 )
 
-func LoadSounds() error {
-	ss := []struct {
-		Resource []byte
-		Sound    Sound
-		FileType fileType
-		Volume   float64
-	}{
-		{seExplosion, SEExplosion, fileTypeWav, 0.125},
-		{bgm, BGM, fileTypeOgg, 0.25},
-	}
-
-	for _, s := range ss {
-		err := load(s.Resource, s.Sound, s.FileType, s.Volume)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 type fileType int
 
 const (
 	fileTypeWav fileType = iota
 	fileTypeMp3
 	fileTypeOgg
+	fileTypePCM // 16-bit little endian stereo PCM at sampleRate, used by generated sounds.
 )
 
-func load(resource []byte, sound Sound, ftype fileType, vol float64) error {
+type soundSource struct {
+	Resource   []byte
+	FileType   fileType
+	Volume     float64 // Volume is the volume of the sound before the channel volumes are applied.
+	Loop       bool
+	LoopLength int64 // LoopLength is the length of the decoded stream in bytes. Zero means the length of Resource.
+}
+
+var soundSources = map[Sound]soundSource{
+	BGM:            {bgm, fileTypeOgg, 0.25, true, bgmLength},
+	BGMTitle:       {generateTones(bgmTitleNotes, 0.3), fileTypePCM, 0.15, true, 0},
+	BGMVictory:     {generateTones(bgmVictoryNotes, 0.2), fileTypePCM, 0.15, true, 0},
+	BGMDefeat:      {generateTones(bgmDefeatNotes, 0.5), fileTypePCM, 0.15, true, 0},
+	SEExplosion:    {seExplosion, fileTypeWav, 0.125, false, 0},
+	SECardPlayed:   {generateTones([]float64{880}, 0.05), fileTypePCM, 0.2, false, 0},
+	SEConstruction: {generateTones([]float64{220, 330}, 0.06), fileTypePCM, 0.25, false, 0},
+	SEPackOpened:   {generateTones([]float64{523.25, 659.25, 783.99, 1046.5}, 0.06), fileTypePCM, 0.2, false, 0},
+	SETurnEnded:    {generateTones([]float64{440, 329.63}, 0.1), fileTypePCM, 0.2, false, 0},
+	SEBattleLost:   {generateTones([]float64{392, 329.63, 261.63}, 0.15), fileTypePCM, 0.25, false, 0},
+	SEVictory:      {generateTones([]float64{523.25, 659.25, 783.99, 1046.5, 1046.5}, 0.15), fileTypePCM, 0.25, false, 0},
+}
+
+// NewSoundPlayer creates a new player of the sound. A looping sound loops forever.
+// Each call decodes the sound again, so keep the player of a sound played repeatedly.
+func NewSoundPlayer(sound Sound) (*audio.Player, error) {
+	src, ok := soundSources[sound]
+	if !ok {
+		return nil, errors.New("unknown sound")
+	}
+
 	var s io.ReadSeeker
 	var err error
 
-	switch ftype {
+	switch src.FileType {
 	case fileTypeWav:
-		s, err = wav.DecodeWithSampleRate(sampleRate, bytes.NewReader(resource))
-		if err != nil {
-			return err
-		}
+		s, err = wav.DecodeWithSampleRate(sampleRate, bytes.NewReader(src.Resource))
 	case fileTypeMp3:
-		s, err = mp3.DecodeWithSampleRate(sampleRate, bytes.NewReader(resource))
-		if err != nil {
-			return err
-		}
+		s, err = mp3.DecodeWithSampleRate(sampleRate, bytes.NewReader(src.Resource))
 	case fileTypeOgg:
-		s, err = vorbis.DecodeWithSampleRate(sampleRate, bytes.NewReader(resource))
-		if err != nil {
-			return err
-		}
+		s, err = vorbis.DecodeWithSampleRate(sampleRate, bytes.NewReader(src.Resource))
+	case fileTypePCM:
+		s = bytes.NewReader(src.Resource)
 	default:
-		return errors.New("not supported filetype")
+		err = errors.New("not supported filetype")
 	}
-
-	// BGM loops
-	if sound == BGM {
-		s = audio.NewInfiniteLoop(s, bgmLength) //int64(len(resource)))
-	}
-
-	p, err := context.NewPlayer(s)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	p.SetVolume(vol)
-	soundCache[sound] = p
 
-	return nil
-}
-
-var soundCache map[Sound]*audio.Player
-
-func PlaySound(s Sound) {
-	p := soundCache[s]
-	err := p.Rewind()
-	if err != nil {
-		log.Println(err)
+	if src.Loop {
+		length := src.LoopLength
+		if length == 0 {
+			length = int64(len(src.Resource))
+		}
+		s = audio.NewInfiniteLoop(s, length)
 	}
-	p.Play()
+
+	return context.NewPlayer(s)
 }
 
-func StopSound(s Sound) {
-	p := soundCache[s]
-	p.Pause()
+// SoundVolume returns the volume of the sound before the channel volumes are applied.
+func SoundVolume(sound Sound) float64 {
+	return soundSources[sound].Volume
 }
@@ -0,0 +1,51 @@
+package asset
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// generateTones generates a sequence of short sine tones, each lasting noteSeconds, as PCM.
+// Each note decays linearly so that it does not click at the end.
+func generateTones(freqs []float64, noteSeconds float64) []byte {
+	samplesPerNote := int(float64(sampleRate) * noteSeconds)
+	buf := make([]byte, 0, len(freqs)*samplesPerNote*4)
+
+	for _, freq := range freqs {
+		for i := range samplesPerNote {
+			envelope := 1 - float64(i)/float64(samplesPerNote)
+			v := math.Sin(2*math.Pi*freq*float64(i)/float64(sampleRate)) * envelope
+			sample := uint16(int16(v * math.MaxInt16))
+			// Left and right channels
+			buf = binary.LittleEndian.AppendUint16(buf, sample)
+			buf = binary.LittleEndian.AppendUint16(buf, sample)
+		}
+	}
+
+	return buf
+}
+
+// The generated BGMs are arpeggios looped forever.
+var (
+	// bgmTitleNotes is a calm arpeggio over C, Am, F and G.
+	bgmTitleNotes = []float64{
+		261.63, 329.63, 392.00, 523.25, 392.00, 329.63,
+		220.00, 261.63, 329.63, 440.00, 329.63, 261.63,
+		174.61, 220.00, 261.63, 349.23, 261.63, 220.00,
+		196.00, 246.94, 293.66, 392.00, 293.66, 246.94,
+	}
+	// bgmVictoryNotes is a bright arpeggio over D, G and A.
+	bgmVictoryNotes = []float64{
+		293.66, 369.99, 440.00, 587.33, 440.00, 369.99, 293.66, 369.99,
+		392.00, 493.88, 587.33, 783.99, 587.33, 493.88, 392.00, 493.88,
+		440.00, 554.37, 659.25, 880.00, 659.25, 554.37, 440.00, 554.37,
+		293.66, 369.99, 440.00, 587.33, 739.99, 587.33, 440.00, 369.99,
+	}
+	// bgmDefeatNotes is a slow arpeggio over Am, F, Dm and E.
+	bgmDefeatNotes = []float64{
+		220.00, 261.63, 329.63, 261.63,
+		174.61, 220.00, 261.63, 220.00,
+		146.83, 174.61, 220.00, 174.61,
+		164.81, 207.65, 246.94, 207.65,
+	}
+)
@@ -0,0 +1,33 @@
+// Command langaudit reports the lang keys that are missing from, or only present in some of, the CSVs under asset/lang.
+//
+// Run it from the repository root:
+//
+//	go run ./cmd/langaudit
+//
+// It exits with status 1 if a key is missing or untranslated. Unused keys are reported but do not fail the audit.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/lang/langaudit"
+)
+
+func main() {
+	langDir := flag.String("lang", "asset/lang", "directory of the lang CSVs")
+	sourceDir := flag.String("src", ".", "directory of the Go source searched for key literals")
+	flag.Parse()
+
+	report, err := langaudit.Audit(*langDir, *sourceDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "langaudit:", err)
+		os.Exit(2)
+	}
+
+	report.WriteTo(os.Stdout)
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,143 @@
+package core
+
+// AchievementID is the identifier of an Achievement.
+type AchievementID string
+
+// Achievement is a goal unlocked when its Condition is met.
+type Achievement struct {
+	ID             AchievementID
+	NameKey        string // NameKey is the lang key of the name.
+	DescriptionKey string // DescriptionKey is the lang key of the description.
+	Condition      AchievementCondition
+}
+
+// AchievementCondition decides whether an Achievement is unlocked by an Event.
+type AchievementCondition interface {
+	IsMet(event *Event, gameState *GameState) bool
+}
+
+// AchievementConditionBattleWon is met when a battle is won against EnemyID.
+// If CardIDs is not empty, all the BattleCards played must be one of CardIDs.
+type AchievementConditionBattleWon struct {
+	EnemyID EnemyID
+	CardIDs []CardID
+}
+
+func (c *AchievementConditionBattleWon) IsMet(event *Event, gameState *GameState) bool {
+	if event.Type != EventTypeBattleWon || event.Enemy == nil {
+		return false
+	}
+	if c.EnemyID != "" && event.Enemy.ID() != c.EnemyID {
+		return false
+	}
+	if len(c.CardIDs) == 0 {
+		return true
+	}
+
+	if len(event.BattleCards) == 0 {
+		return false
+	}
+	for _, card := range event.BattleCards {
+		if !c.allowed(card.CardID) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *AchievementConditionBattleWon) allowed(cardID CardID) bool {
+	for _, id := range c.CardIDs {
+		if id == cardID {
+			return true
+		}
+	}
+	return false
+}
+
+// AchievementConditionVictoryBefore is met when the game is won before Turn.
+type AchievementConditionVictoryBefore struct {
+	Turn Turn
+}
+
+func (c *AchievementConditionVictoryBefore) IsMet(event *Event, gameState *GameState) bool {
+	return event.Type == EventTypeVictory && event.Turn < c.Turn
+}
+
+// AchievementConditionTerritoryFilled is met when every card slot of a Territory is filled.
+type AchievementConditionTerritoryFilled struct{}
+
+func (c *AchievementConditionTerritoryFilled) IsMet(event *Event, gameState *GameState) bool {
+	if event.Type != EventTypeConstructionCommitted || event.Territory == nil {
+		return false
+	}
+	slot := event.Territory.CardSlot()
+	return slot > 0 && len(event.Territory.Cards()) >= slot
+}
+
+// AchievementConditionEventCount is met when events of Type have happened Count times in a run.
+type AchievementConditionEventCount struct {
+	Type  EventType
+	Count int
+	count int
+}
+
+func (c *AchievementConditionEventCount) IsMet(event *Event, gameState *GameState) bool {
+	if event.Type != c.Type {
+		return false
+	}
+	c.count++
+	return c.count >= c.Count
+}
+
+// AchievementTracker evaluates Achievements on every Event and records the unlocked ones.
+type AchievementTracker struct {
+	achievements []*Achievement
+	unlocked     map[AchievementID]bool
+	onUnlock     func(achievement *Achievement)
+}
+
+// NewAchievementTracker creates a new AchievementTracker. unlocked are the IDs already unlocked in previous runs.
+// onUnlock is called when an Achievement is newly unlocked; it can be nil.
+func NewAchievementTracker(achievements []*Achievement, unlocked []AchievementID, onUnlock func(achievement *Achievement)) *AchievementTracker {
+	t := &AchievementTracker{
+		achievements: achievements,
+		unlocked:     make(map[AchievementID]bool),
+		onUnlock:     onUnlock,
+	}
+	for _, id := range unlocked {
+		t.unlocked[id] = true
+	}
+	return t
+}
+
+// HandleEvent is an EventListener to be passed to GameState.Subscribe.
+func (t *AchievementTracker) HandleEvent(event *Event, gameState *GameState) {
+	for _, achievement := range t.achievements {
+		if t.unlocked[achievement.ID] {
+			continue
+		}
+		if !achievement.Condition.IsMet(event, gameState) {
+			continue
+		}
+		t.unlocked[achievement.ID] = true
+		if t.onUnlock != nil {
+			t.onUnlock(achievement)
+		}
+	}
+}
+
+// IsUnlocked returns true if the Achievement has been unlocked.
+func (t *AchievementTracker) IsUnlocked(id AchievementID) bool {
+	return t.unlocked[id]
+}
+
+// Unlocked returns the IDs of the unlocked Achievements in the order of the definitions.
+func (t *AchievementTracker) Unlocked() []AchievementID {
+	ids := make([]AchievementID, 0, len(t.unlocked))
+	for _, achievement := range t.achievements {
+		if t.unlocked[achievement.ID] {
+			ids = append(ids, achievement.ID)
+		}
+	}
+	return ids
+}
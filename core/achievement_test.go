@@ -0,0 +1,72 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestAchievementTracker(t *testing.T) {
+	dragon := core.NewEnemy("enemy-dragon", "dragon", 30.0, []*core.EnemySkill{}, 6)
+	knight := core.NewBattleCard("battlecard-knight", 5.0, nil, "cardtype-str")
+	soldier := core.NewBattleCard("battlecard-soldier", 2.0, nil, "cardtype-str")
+
+	achievements := []*core.Achievement{
+		{ID: "knights-only", Condition: &core.AchievementConditionBattleWon{EnemyID: "enemy-dragon", CardIDs: []core.CardID{"battlecard-knight"}}},
+		{ID: "early-victory", Condition: &core.AchievementConditionVictoryBefore{Turn: 12}},
+		{ID: "already", Condition: &core.AchievementConditionVictoryBefore{Turn: 100}},
+	}
+
+	var unlocked []core.AchievementID
+	tracker := core.NewAchievementTracker(achievements, []core.AchievementID{"already"}, func(achievement *core.Achievement) {
+		unlocked = append(unlocked, achievement.ID)
+	})
+
+	gameState := &core.GameState{}
+	gameState.Subscribe(tracker.HandleEvent)
+
+	// Mixed cards do not count.
+	gameState.Notify(&core.Event{Type: core.EventTypeBattleWon, Enemy: dragon, BattleCards: []*core.BattleCard{knight, soldier}})
+	if tracker.IsUnlocked("knights-only") {
+		t.Errorf("knights-only should not be unlocked with a soldier")
+	}
+
+	gameState.Notify(&core.Event{Type: core.EventTypeBattleWon, Enemy: dragon, BattleCards: []*core.BattleCard{knight, knight}})
+	if !tracker.IsUnlocked("knights-only") {
+		t.Errorf("knights-only should be unlocked")
+	}
+
+	// The turn is set by Notify.
+	gameState.CurrentTurn = 12
+	gameState.Notify(&core.Event{Type: core.EventTypeVictory})
+	if tracker.IsUnlocked("early-victory") {
+		t.Errorf("early-victory should not be unlocked in turn 12")
+	}
+
+	if len(unlocked) != 1 || unlocked[0] != "knights-only" {
+		t.Errorf("onUnlock calls = %v, want [knights-only]", unlocked)
+	}
+
+	want := []core.AchievementID{"knights-only", "already"}
+	got := tracker.Unlocked()
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Unlocked() = %v, want %v", got, want)
+	}
+}
+
+func TestAchievementConditionTerritoryFilled(t *testing.T) {
+	terrain := core.NewTerrain("plain", core.ResourceQuantity{Food: 1}, 2)
+	territory := core.NewTerritory("territory", terrain)
+	condition := &core.AchievementConditionTerritoryFilled{}
+	event := &core.Event{Type: core.EventTypeConstructionCommitted, Territory: territory}
+
+	territory.AppendCard(core.NewStructureCard("farm", core.ResourceQuantity{}, core.ResourceModifier{}, 0.0, 0))
+	if condition.IsMet(event, nil) {
+		t.Errorf("IsMet() = true with an empty slot, want false")
+	}
+
+	territory.AppendCard(core.NewStructureCard("farm", core.ResourceQuantity{}, core.ResourceModifier{}, 0.0, 0))
+	if !condition.IsMet(event, nil) {
+		t.Errorf("IsMet() = false with all slots filled, want true")
+	}
+}
@@ -1,39 +1,183 @@
 package core
 
+import "slices"
+
+// BattleMode determines how a battle is fought.
+type BattleMode int
+
+const (
+	BattleModeOneShot    BattleMode = iota // The total power is compared with the enemy power once.
+	BattleModeMultiRound                   // The enemy has HP and the player attacks over several rounds.
+)
+
+// MultiRoundBattleMaxRounds is the number of rounds the player can attack in a multi-round battle.
+const MultiRoundBattleMaxRounds = 3
+
 // Battlefield represents a battle instance, created when starting a battle in an unconquered Wilderness.
 type Battlefield struct {
 	Point            BattlePoint
 	Enemy            *Enemy        // Enemy is the opponent in the battle.
-	BaseSupportPower float64       // BaseSupportPower is the power gained from StructureCards in adjacent Territories.
-	BattleCards      []*BattleCard // BattleCards is a collection of BattleCards played during the battle.
+	BaseSupportPower float64       // BaseSupportPower is the power gained from StructureCards in the Territories around.
+	BattleCards      []*BattleCard // BattleCards is a collection of BattleCards played during the battle (or the current round).
 	CardSlot         int           // CardSlot is the maximum number of BattleCards that can be placed.
+
+	SupportContributions []*SupportContribution // SupportContributions are the Territories that give BaseSupportPower and CardSlot.
+	ForbiddenCardTypes   []BattleCardType       // ForbiddenCardTypes are the BattleCardTypes that cannot be placed.
+	Terrain              *Terrain               // Terrain is where the battle is fought. It can be nil.
+
+	Mode            BattleMode    // Mode is how the battle is fought.
+	Round           int           // Round is the current round of a multi-round battle, starting from 1.
+	MaxRounds       int           // MaxRounds is the number of rounds of a multi-round battle.
+	EnemyHP         float64       // EnemyHP is the remaining HP of the enemy in a multi-round battle.
+	UsedBattleCards []*BattleCard // UsedBattleCards are the BattleCards played in finished rounds.
 }
 
 // CanBeat returns true if the player's power is enough to defeat the enemy.
+// In a multi-round battle, it returns true if the current round finishes the enemy.
 func (b *Battlefield) CanBeat() bool {
-	totalPower := b.CalculateTotalPower()
-	return totalPower >= b.Enemy.Power()
+	totalPower, enemyPowerBonus := b.calculate()
+	return totalPower >= b.requiredPower()+enemyPowerBonus
+}
+
+// CalculateEnemyPower returns the power needed to defeat the enemy, including bonuses from enemy skills.
+// In a multi-round battle, the remaining HP is used instead of the enemy power.
+func (b *Battlefield) CalculateEnemyPower() float64 {
+	_, enemyPowerBonus := b.calculate()
+	return b.requiredPower() + enemyPowerBonus
+}
+
+func (b *Battlefield) requiredPower() float64 {
+	if b.Mode == BattleModeMultiRound {
+		return b.EnemyHP
+	}
+	return b.Enemy.Power()
+}
+
+// NewMultiRoundBattlefield creates a new Battlefield in which the enemy has HP equal to its power.
+func NewMultiRoundBattlefield(enemy *Enemy, supportPower float64, maxRounds int) *Battlefield {
+	b := NewBattlefield(enemy, supportPower)
+	b.Mode = BattleModeMultiRound
+	b.Round = 1
+	b.MaxRounds = maxRounds
+	b.EnemyHP = enemy.Power()
+	return b
+}
+
+// CurrentRound returns the current round. A one-shot battle always has a single round.
+func (b *Battlefield) CurrentRound() int {
+	if b.Round < 1 {
+		return 1
+	}
+	return b.Round
+}
+
+// IsFinalRound returns true if no more rounds follow the current one.
+func (b *Battlefield) IsFinalRound() bool {
+	if b.Mode != BattleModeMultiRound {
+		return true
+	}
+	return b.CurrentRound() >= b.MaxRounds
+}
+
+// Attack deals the current total power as damage to the enemy and starts the next round.
+// It returns false in a one-shot battle or in the final round.
+func (b *Battlefield) Attack() bool {
+	if b.IsFinalRound() {
+		return false
+	}
+
+	totalPower, enemyPowerBonus := b.calculate()
+	if damage := totalPower - enemyPowerBonus; damage > 0 {
+		b.EnemyHP -= damage
+	}
+	b.UsedBattleCards = append(b.UsedBattleCards, b.BattleCards...)
+	b.BattleCards = make([]*BattleCard, 0, b.CardSlot)
+	b.Round = b.CurrentRound() + 1
+	return true
+}
+
+// AllBattleCards returns the BattleCards played in finished rounds and in the current round.
+func (b *Battlefield) AllBattleCards() []*BattleCard {
+	result := make([]*BattleCard, 0, len(b.UsedBattleCards)+len(b.BattleCards))
+	result = append(result, b.UsedBattleCards...)
+	result = append(result, b.BattleCards...)
+	return result
 }
 
 // NewBattlefield creates a new Battlefield instance.
+// The enemy skills are prepared here, so they can change the rules of the battle.
 func NewBattlefield(enemy *Enemy, supportPower float64) *Battlefield {
-	return &Battlefield{
+	b := &Battlefield{
 		Enemy:            enemy,
 		BaseSupportPower: supportPower,
 		BattleCards:      make([]*BattleCard, 0, enemy.BattleCardSlot()),
 		CardSlot:         enemy.BattleCardSlot(),
 	}
+
+	for _, skill := range enemy.Skills() {
+		skill.Prepare(b)
+	}
+
+	return b
 }
 
-// AddBattleCard adds a BattleCard to the battlefield.
-func (b *Battlefield) AddBattleCard(card *BattleCard) bool {
+// ApplyTerrain sets the Terrain where the battle is fought and applies its CardSlot changes. The CardSlot never goes below 1.
+func (b *Battlefield) ApplyTerrain(terrain *Terrain) {
+	b.Terrain = terrain
+	if terrain == nil {
+		return
+	}
+
+	for _, effect := range terrain.BattleEffects() {
+		b.CardSlot += effect.CardSlotDelta
+	}
+	if b.CardSlot < 1 {
+		b.CardSlot = 1
+	}
+}
+
+// TerrainBattleEffects returns the effects of the Terrain on this battle.
+func (b *Battlefield) TerrainBattleEffects() []*TerrainBattleEffect {
+	if b.Terrain == nil {
+		return nil
+	}
+	return b.Terrain.BattleEffects()
+}
+
+// CanPlace returns true if the BattleCard can be added to the battlefield.
+func (b *Battlefield) CanPlace(card *BattleCard) bool {
 	if len(b.BattleCards) >= b.CardSlot {
 		return false // Slot limit reached
 	}
-	b.BattleCards = append(b.BattleCards, card)
+	for _, t := range b.ForbiddenCardTypes {
+		if card.Type == t {
+			return false
+		}
+	}
+	return true
+}
+
+// AddBattleCard adds a BattleCard to the end of the battlefield.
+func (b *Battlefield) AddBattleCard(card *BattleCard) bool {
+	return b.InsertBattleCard(len(b.BattleCards), card)
+}
+
+// InsertBattleCard places a BattleCard at the index and shifts the following cards.
+// The index is clamped to the placed cards, so an index past the end appends the card.
+func (b *Battlefield) InsertBattleCard(index int, card *BattleCard) bool {
+	if !b.CanPlace(card) {
+		return false
+	}
+	index = min(max(index, 0), len(b.BattleCards))
+	b.BattleCards = slices.Insert(b.BattleCards, index, card)
 	return true
 }
 
+// MoveBattleCard moves the BattleCard at from so that it ends up at to. The cards in between shift by one.
+func (b *Battlefield) MoveBattleCard(from, to int) bool {
+	return moveElement(b.BattleCards, from, to)
+}
+
 // RemoveBattleCard removes a BattleCard from the battlefield.
 func (b *Battlefield) RemoveBattleCard(index int) (*BattleCard, bool) {
 	if index < 0 || index >= len(b.BattleCards) {
@@ -45,42 +189,184 @@ func (b *Battlefield) RemoveBattleCard(index int) (*BattleCard, bool) {
 	return card, true
 }
 
+// moveElement moves s[from] to s[to] in place, shifting the elements in between.
+func moveElement[T any](s []T, from, to int) bool {
+	if from < 0 || from >= len(s) || to < 0 || to >= len(s) {
+		return false
+	}
+	v := s[from]
+	if from < to {
+		copy(s[from:to], s[from+1:to+1])
+	} else {
+		copy(s[to+1:from+1], s[to:from])
+	}
+	s[to] = v
+	return true
+}
+
+// Survival rates used to decide which BattleCards return to the deck after a battle.
+const (
+	VictorySurvivalRate = 0.5 // Survival rate on a victory with a margin of exactly 1.0. It grows with the margin.
+	DefeatSurvivalRate  = 0.5 // Survival rate on a defeat with a margin close to 1.0. It shrinks with the margin.
+)
+
+// BattleResult is the outcome of a resolved battle.
+type BattleResult struct {
+	Victory   bool
+	Margin    float64       // Margin is the total power divided by the enemy power.
+	Survivors []*BattleCard // Survivors are the BattleCards returned to the deck.
+	Losses    []*BattleCard // Losses are the BattleCards lost in the battle.
+}
+
+// Margin returns the total power divided by the enemy power.
+// In a multi-round battle, the damage dealt in finished rounds is included.
+func (b *Battlefield) Margin() float64 {
+	enemyPower := b.Enemy.Power()
+	totalPower, enemyPowerBonus := b.calculate()
+	if b.Mode == BattleModeMultiRound {
+		totalPower += enemyPower - b.EnemyHP - enemyPowerBonus
+	} else {
+		enemyPower += enemyPowerBonus
+	}
+
+	if enemyPower <= 0 {
+		return 2.0 // Treat a powerless enemy as an overwhelming victory.
+	}
+	return totalPower / enemyPower
+}
+
+// SurvivalRate returns the probability that the given BattleCard survives the battle.
+func (b *Battlefield) SurvivalRate(card *BattleCard, victory bool, margin float64) float64 {
+	var rate float64
+	if victory {
+		rate = VictorySurvivalRate * margin
+	} else {
+		rate = DefeatSurvivalRate * margin
+	}
+
+	if card.Skill != nil {
+		rate += card.Skill.SurvivalRateBonus
+	}
+
+	if rate < 0.0 {
+		return 0.0
+	}
+	if rate > 1.0 {
+		return 1.0
+	}
+	return rate
+}
+
+// Resolve fights the battle and decides the survivors and losses using intner.
+// In a multi-round battle, the BattleCards of finished rounds are also subject to casualties.
+// The BattleCards on the battlefield are not modified.
+func (b *Battlefield) Resolve(intner Intner) *BattleResult {
+	margin := b.Margin()
+	result := &BattleResult{
+		Victory: b.CanBeat(),
+		Margin:  margin,
+	}
+
+	for _, card := range b.AllBattleCards() {
+		rate := b.SurvivalRate(card, result.Victory, margin)
+		if intner.Intn(100) < int(rate*100) {
+			result.Survivors = append(result.Survivors, card)
+		} else {
+			result.Losses = append(result.Losses, card)
+		}
+	}
+
+	return result
+}
+
+// CalculateTotalPower returns the power of the player, including the support power.
 func (b *Battlefield) CalculateTotalPower() float64 {
+	totalPower, _ := b.calculate()
+	return totalPower
+}
+
+// calculate returns the total power of the player and the bonus power of the enemy.
+func (b *Battlefield) calculate() (totalPower, enemyPowerBonus float64) {
+	breakdown := b.Breakdown()
+	return breakdown.TotalPower(), breakdown.EnemyPowerBonus
+}
+
+// BattleBreakdown is the detail of the power calculation of a Battlefield.
+type BattleBreakdown struct {
+	SupportPower    float64                // SupportPower is the support power after multipliers. It is 0 if negated by the enemy.
+	CardPowers      []float64              // CardPowers are the powers of the BattleCards after all modifiers.
+	TerrainEffects  []*TerrainBattleEffect // TerrainEffects are the effects of the Terrain on the battle.
+	EnemyPowerBonus float64                // EnemyPowerBonus is the power added to the enemy by its skills.
+}
+
+// TotalPower returns the sum of the support power and the powers of the BattleCards.
+func (bd *BattleBreakdown) TotalPower() float64 {
+	totalPower := bd.SupportPower
+	for _, power := range bd.CardPowers {
+		totalPower += power
+	}
+	return totalPower
+}
+
+// Breakdown calculates the power of the current round in detail.
+func (b *Battlefield) Breakdown() *BattleBreakdown {
 	modifiers := make([]*BattleCardPowerModifier, len(b.BattleCards))
 	for i := range modifiers {
 		modifiers[i] = &BattleCardPowerModifier{}
 	}
 
+	round := b.CurrentRound()
+
 	cardCalcOptions := &BattleCardSkillCalculationOptions{
 		BattleCards:              b.BattleCards,
 		BattleCardPowerModifiers: modifiers,
 		Enemy:                    b.Enemy,
+		Round:                    round,
 	}
 
 	for i, card := range b.BattleCards {
 		cardCalcOptions.BattleCardIndex = i
-		if card.Skill != nil {
+		if card.Skill != nil && card.Skill.Timing.IsActive(round) {
 			card.Skill.Calculate(cardCalcOptions)
 		}
 	}
 
+	for _, effect := range b.TerrainBattleEffects() {
+		for i, card := range b.BattleCards {
+			if effect.Affects(card) {
+				modifiers[i].Union(effect.Modifier)
+			}
+		}
+	}
+
 	enemyCalcOptions := &EnemySkillCalculationOptions{
+		Battlefield:              b,
 		BattleCards:              b.BattleCards,
 		BattleCardPowerModifiers: modifiers,
 		Enemy:                    b.Enemy,
+		Round:                    round,
 	}
 
 	for _, skill := range b.Enemy.Skills() {
+		if !skill.TriggersInRound(round) {
+			continue
+		}
 		skill.Calculate(enemyCalcOptions)
 	}
 
-	totalPower := b.BaseSupportPower * (cardCalcOptions.SupportPowerMultiplier + 1.0)
+	breakdown := &BattleBreakdown{
+		CardPowers:      make([]float64, len(b.BattleCards)),
+		TerrainEffects:  b.TerrainBattleEffects(),
+		EnemyPowerBonus: enemyCalcOptions.EnemyPowerBonus,
+	}
+	if !enemyCalcOptions.NegateSupportPower {
+		breakdown.SupportPower = b.BaseSupportPower * (cardCalcOptions.SupportPowerMultiplier + 1.0)
+	}
 	for i, card := range b.BattleCards {
 		power := float64(card.Power())
-		power = modifiers[i].Calculate(power)
-		totalPower += power
+		breakdown.CardPowers[i] = modifiers[i].Calculate(power)
 	}
-	return totalPower
+	return breakdown
 }
 
 type BattleCardPowerModifier struct {
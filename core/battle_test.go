@@ -1,6 +1,7 @@
 package core_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
@@ -140,3 +141,363 @@ func TestBattlefield_PowerCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestBattlefield_Resolve(t *testing.T) {
+	enemy := core.NewEnemy("resolve_test_enemy", "goblin", 10.0, []*core.EnemySkill{}, 3)
+
+	card := core.NewBattleCard("card", 10.0, nil, "warrior")
+	luckyCard := core.NewBattleCard("lucky", 10.0, &core.BattleCardSkill{
+		BattleCardSkillID: "lucky",
+		Calculator:        core.NopBattleCardSkillCalculation,
+		SurvivalRateBonus: 0.3,
+	}, "warrior")
+
+	tests := []struct {
+		name          string
+		cards         []*core.BattleCard
+		rolls         []int
+		wantVictory   bool
+		wantSurvivors int
+		wantLosses    int
+	}{
+		{
+			name:          "Overwhelming victory keeps every card",
+			cards:         []*core.BattleCard{card, card}, // margin 2.0, rate 1.0
+			rolls:         []int{99, 99},
+			wantVictory:   true,
+			wantSurvivors: 2,
+			wantLosses:    0,
+		},
+		{
+			name:          "Narrow victory loses unlucky cards",
+			cards:         []*core.BattleCard{card}, // margin 1.0, rate 0.5
+			rolls:         []int{50},
+			wantVictory:   true,
+			wantSurvivors: 0,
+			wantLosses:    1,
+		},
+		{
+			name:          "Skill bonus improves survival",
+			cards:         []*core.BattleCard{luckyCard}, // margin 1.0, rate 0.8
+			rolls:         []int{50},
+			wantVictory:   true,
+			wantSurvivors: 1,
+			wantLosses:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			battlefield := &core.Battlefield{
+				Enemy:       enemy,
+				BattleCards: tt.cards,
+			}
+
+			result := battlefield.Resolve(&MockIntner{values: tt.rolls})
+			if result.Victory != tt.wantVictory {
+				t.Errorf("Victory = %v, want %v", result.Victory, tt.wantVictory)
+			}
+			if len(result.Survivors) != tt.wantSurvivors {
+				t.Errorf("len(Survivors) = %d, want %d", len(result.Survivors), tt.wantSurvivors)
+			}
+			if len(result.Losses) != tt.wantLosses {
+				t.Errorf("len(Losses) = %d, want %d", len(result.Losses), tt.wantLosses)
+			}
+		})
+	}
+}
+
+func TestBattlefield_ResolveDefeat(t *testing.T) {
+	enemy := core.NewEnemy("resolve_test_enemy", "goblin", 20.0, []*core.EnemySkill{}, 3)
+	card := core.NewBattleCard("card", 5.0, nil, "warrior")
+
+	battlefield := &core.Battlefield{
+		Enemy:       enemy,
+		BattleCards: []*core.BattleCard{card, card}, // margin 0.5, rate 0.25
+	}
+
+	result := battlefield.Resolve(&MockIntner{values: []int{10, 30}})
+	if result.Victory {
+		t.Errorf("Victory = true, want false")
+	}
+	if len(result.Survivors) != 1 || len(result.Losses) != 1 {
+		t.Errorf("Survivors/Losses = %d/%d, want 1/1", len(result.Survivors), len(result.Losses))
+	}
+}
+
+func TestBattlefield_MultiRound(t *testing.T) {
+	enemy := core.NewEnemy("multi_round_enemy", "dragon", 20.0, []*core.EnemySkill{}, 3)
+	card := core.NewBattleCard("card", 8.0, nil, "warrior")
+
+	battlefield := core.NewMultiRoundBattlefield(enemy, 0.0, 2)
+	battlefield.AddBattleCard(card)
+
+	if battlefield.CanBeat() {
+		t.Fatalf("CanBeat() = true in round 1, want false") // 8 < 20
+	}
+
+	if !battlefield.Attack() {
+		t.Fatalf("Attack() = false, want true")
+	}
+	if battlefield.EnemyHP != 12.0 {
+		t.Errorf("EnemyHP = %v, want %v", battlefield.EnemyHP, 12.0)
+	}
+	if battlefield.CurrentRound() != 2 {
+		t.Errorf("CurrentRound() = %d, want %d", battlefield.CurrentRound(), 2)
+	}
+	if len(battlefield.BattleCards) != 0 || len(battlefield.UsedBattleCards) != 1 {
+		t.Errorf("BattleCards/UsedBattleCards = %d/%d, want 0/1", len(battlefield.BattleCards), len(battlefield.UsedBattleCards))
+	}
+
+	battlefield.AddBattleCard(card)
+	battlefield.AddBattleCard(card)
+	if !battlefield.CanBeat() {
+		t.Errorf("CanBeat() = false in round 2, want true") // 16 >= 12
+	}
+	if battlefield.Attack() {
+		t.Errorf("Attack() = true in the final round, want false")
+	}
+	if len(battlefield.AllBattleCards()) != 3 {
+		t.Errorf("len(AllBattleCards()) = %d, want %d", len(battlefield.AllBattleCards()), 3)
+	}
+}
+
+func TestBattlefield_SkillTiming(t *testing.T) {
+	wave := core.NewIntervalEnemySkill("wave", 2, func(idx int, options *core.EnemySkillCalculationOptions) bool {
+		return true
+	}, &core.BattleCardPowerModifier{AdditiveDebuff: 2.0})
+	enemy := core.NewEnemy("timing_enemy", "dragon", 100.0, []*core.EnemySkill{wave}, 3)
+
+	firstStrike := core.NewBattleCard("first_strike", 5.0, &core.BattleCardSkill{
+		BattleCardSkillID: "first_strike",
+		Timing:            core.BattleCardSkillTimingFirstRound,
+		Calculator: &core.BattleCardSkillCalculatorEffectSelf{
+			Effect: &core.BattleCardSkillEffect{
+				Modifier: &core.BattleCardPowerModifier{AdditiveBuff: 5.0},
+			},
+		},
+	}, "warrior")
+
+	battlefield := core.NewMultiRoundBattlefield(enemy, 0.0, 3)
+
+	want := []float64{
+		8.0, // Round 1: 5 + 5 - 2
+		5.0, // Round 2: no skills
+		3.0, // Round 3: 5 - 2
+	}
+	for i, w := range want {
+		battlefield.AddBattleCard(firstStrike)
+		if got := battlefield.CalculateTotalPower(); got != w {
+			t.Errorf("round %d: CalculateTotalPower() = %v, want %v", i+1, got, w)
+		}
+		battlefield.Attack()
+	}
+}
+
+func TestBattlefield_RoundTriggersDecideOutcome(t *testing.T) {
+	tests := []struct {
+		name            string
+		eruptionEvery   int
+		bushidoTiming   core.BattleCardSkillTiming
+		expectedVictory bool
+	}{
+		{
+			name:            "Eruption every other round, bushido in the first round",
+			eruptionEvery:   2,
+			bushidoTiming:   core.BattleCardSkillTimingFirstRound,
+			expectedVictory: true, // 9 + 10 + 4 >= 20
+		},
+		{
+			name:            "Eruption every round, bushido in the first round",
+			eruptionEvery:   1,
+			bushidoTiming:   core.BattleCardSkillTimingFirstRound,
+			expectedVictory: false, // 9 + 4 + 4 < 20
+		},
+		{
+			name:            "Eruption every round, bushido in every round",
+			eruptionEvery:   1,
+			bushidoTiming:   core.BattleCardSkillTimingAlways,
+			expectedVictory: true, // 9 + 9 + 9 >= 20
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eruption := core.NewIntervalEnemySkill("eruption", tt.eruptionEvery, func(idx int, options *core.EnemySkillCalculationOptions) bool {
+				return true
+			}, &core.BattleCardPowerModifier{AdditiveDebuff: 3.0})
+			enemy := core.NewEnemy("boss", "demonic", 20.0, []*core.EnemySkill{eruption}, 3)
+
+			samurai := core.NewBattleCard("samurai", 5.0, &core.BattleCardSkill{
+				BattleCardSkillID: "bushido",
+				Timing:            tt.bushidoTiming,
+				Calculator: &core.BattleCardSkillCalculatorCondition{
+					Condition: func(options *core.BattleCardSkillCalculationOptions) bool {
+						return options.BattleCardIndex == 0
+					},
+					Calculator: &core.BattleCardSkillCalculatorEffectSelf{
+						Effect: &core.BattleCardSkillEffect{
+							Modifier: &core.BattleCardPowerModifier{MultiplicativeBuff: 1.0},
+						},
+					},
+				},
+			}, "warrior")
+			soldier := core.NewBattleCard("soldier", 5.0, nil, "warrior")
+
+			battlefield := core.NewMultiRoundBattlefield(enemy, 0.0, 3)
+			for {
+				battlefield.AddBattleCard(samurai)
+				battlefield.AddBattleCard(soldier)
+				if !battlefield.Attack() {
+					break
+				}
+			}
+
+			if got := battlefield.Resolve(&MockIntner{}).Victory; got != tt.expectedVictory {
+				t.Errorf("Resolve().Victory = %v, want %v", got, tt.expectedVictory)
+			}
+		})
+	}
+}
+
+func TestBattlefield_EnemySkillCalculators(t *testing.T) {
+	newSkill := func(calculator core.EnemySkillCalculator) []*core.EnemySkill {
+		return []*core.EnemySkill{core.NewEnemySkillWithCalculator("test_skill", 1, calculator)}
+	}
+	cards := []*core.BattleCard{
+		core.NewBattleCard("warrior", 5.0, nil, "cardtype-str"),
+		core.NewBattleCard("mage", 8.0, nil, "cardtype-mag"),
+	}
+
+	tests := []struct {
+		name           string
+		calculator     core.EnemySkillCalculator
+		cardSlot       int
+		wantPlaced     int
+		wantTotalPower float64
+		wantEnemyPower float64
+	}{
+		{"card slot", &core.EnemySkillCalculatorCardSlot{Delta: -2}, 1, 1, 10.0, 20.0},
+		{"card slot never below 1", &core.EnemySkillCalculatorCardSlot{Delta: -5}, 1, 1, 10.0, 20.0},
+		{"negate support power", &core.EnemySkillCalculatorNegateSupportPower{}, 3, 2, 13.0, 20.0},
+		{"forbid card type", &core.EnemySkillCalculatorForbidCardType{CardType: "cardtype-mag"}, 3, 1, 10.0, 20.0},
+		{"copy strongest", &core.EnemySkillCalculatorCopyStrongest{Ratio: 0.5}, 3, 2, 18.0, 24.0},
+		{"power per card", &core.EnemySkillCalculatorPowerPerCard{PowerPerCard: 2.0}, 3, 2, 18.0, 24.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enemy := core.NewEnemy("skill_enemy", "golem", 20.0, newSkill(tt.calculator), 3)
+			battlefield := core.NewBattlefield(enemy, 5.0)
+
+			if battlefield.CardSlot != tt.cardSlot {
+				t.Errorf("CardSlot = %v, want %v", battlefield.CardSlot, tt.cardSlot)
+			}
+			for _, card := range cards {
+				battlefield.AddBattleCard(card)
+			}
+			if len(battlefield.BattleCards) != tt.wantPlaced {
+				t.Errorf("len(BattleCards) = %v, want %v", len(battlefield.BattleCards), tt.wantPlaced)
+			}
+			if got := battlefield.CalculateTotalPower(); got != tt.wantTotalPower {
+				t.Errorf("CalculateTotalPower() = %v, want %v", got, tt.wantTotalPower)
+			}
+			if got := battlefield.CalculateEnemyPower(); got != tt.wantEnemyPower {
+				t.Errorf("CalculateEnemyPower() = %v, want %v", got, tt.wantEnemyPower)
+			}
+		})
+	}
+}
+
+func TestBattlefield_TerrainEffects(t *testing.T) {
+	terrain := core.NewTerrainWithBattleEffects("terrain-forest", core.ResourceQuantity{}, 3, []*core.TerrainBattleEffect{
+		{CardType: "cardtype-agi", Modifier: &core.BattleCardPowerModifier{AdditiveBuff: 1.0}},
+		{CardSlotDelta: -1},
+	})
+	enemy := core.NewEnemy("terrain_enemy", "goblin", 20.0, []*core.EnemySkill{}, 3)
+
+	battlefield := core.NewBattlefield(enemy, 2.0)
+	battlefield.ApplyTerrain(terrain)
+
+	if battlefield.CardSlot != 2 {
+		t.Errorf("CardSlot = %v, want %v", battlefield.CardSlot, 2)
+	}
+
+	battlefield.AddBattleCard(core.NewBattleCard("ninja", 3.0, nil, "cardtype-agi"))
+	battlefield.AddBattleCard(core.NewBattleCard("soldier", 3.0, nil, "cardtype-str"))
+
+	breakdown := battlefield.Breakdown()
+	if breakdown.SupportPower != 2.0 {
+		t.Errorf("SupportPower = %v, want %v", breakdown.SupportPower, 2.0)
+	}
+	if len(breakdown.CardPowers) != 2 || breakdown.CardPowers[0] != 4.0 || breakdown.CardPowers[1] != 3.0 {
+		t.Errorf("CardPowers = %v, want [4 3]", breakdown.CardPowers)
+	}
+	if len(breakdown.TerrainEffects) != 2 {
+		t.Errorf("len(TerrainEffects) = %v, want %v", len(breakdown.TerrainEffects), 2)
+	}
+	if got := battlefield.CalculateTotalPower(); got != 9.0 {
+		t.Errorf("CalculateTotalPower() = %v, want %v", got, 9.0)
+	}
+}
+
+func TestBattlefield_InsertBattleCard(t *testing.T) {
+	enemy := core.NewEnemy("enemy", "goblin", 10.0, nil, 3)
+	a := core.NewBattleCard("a", 1.0, nil, "cardtype-str")
+	b := core.NewBattleCard("b", 1.0, nil, "cardtype-str")
+	c := core.NewBattleCard("c", 1.0, nil, "cardtype-str")
+	d := core.NewBattleCard("d", 1.0, nil, "cardtype-str")
+
+	battlefield := core.NewBattlefield(enemy, 0.0)
+	battlefield.AddBattleCard(a)
+	if !battlefield.InsertBattleCard(0, b) {
+		t.Fatalf("InsertBattleCard(0) = false, want true")
+	}
+	if !battlefield.InsertBattleCard(1, c) {
+		t.Fatalf("InsertBattleCard(1) = false, want true")
+	}
+	if got, want := battleCardIDs(battlefield.BattleCards), []core.CardID{"b", "c", "a"}; !slices.Equal(got, want) {
+		t.Errorf("BattleCards = %v, want %v", got, want)
+	}
+	if battlefield.InsertBattleCard(0, d) {
+		t.Errorf("InsertBattleCard() over the card slot = true, want false")
+	}
+}
+
+func TestBattlefield_MoveBattleCard(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to int
+		want     []core.CardID
+		ok       bool
+	}{
+		{"forward", 0, 2, []core.CardID{"b", "c", "a"}, true},
+		{"backward", 2, 0, []core.CardID{"c", "a", "b"}, true},
+		{"same position", 1, 1, []core.CardID{"a", "b", "c"}, true},
+		{"out of range", 0, 3, []core.CardID{"a", "b", "c"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			battlefield := core.NewBattlefield(core.NewEnemy("enemy", "goblin", 10.0, nil, 3), 0.0)
+			for _, id := range []core.CardID{"a", "b", "c"} {
+				battlefield.AddBattleCard(core.NewBattleCard(id, 1.0, nil, "cardtype-str"))
+			}
+
+			if ok := battlefield.MoveBattleCard(tt.from, tt.to); ok != tt.ok {
+				t.Errorf("MoveBattleCard(%d, %d) = %v, want %v", tt.from, tt.to, ok, tt.ok)
+			}
+			if got := battleCardIDs(battlefield.BattleCards); !slices.Equal(got, tt.want) {
+				t.Errorf("BattleCards = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func battleCardIDs(cards []*core.BattleCard) []core.CardID {
+	ids := make([]core.CardID, len(cards))
+	for i, card := range cards {
+		ids[i] = card.CardID
+	}
+	return ids
+}
@@ -96,21 +96,24 @@ func (c *BattleCard) Power() BattleCardPower {
 
 // StructureCard is a card placed in a Territory. This struct is immutable.
 type StructureCard struct {
-	cardID             CardID
-	yieldAdditiveValue ResourceQuantity // Direct additive yield bonus
-	yieldModifier      ResourceModifier // Multiplicative yield modifier
-	supportPower       float64          // Support power provided to battlefield
-	supportCardSlot    int              // Additional card slots provided to battlefield
+	cardID  CardID
+	effects []StructureEffect
 }
 
-// NewStructureCard creates a new StructureCard instance.
+// NewStructureCard creates a new StructureCard instance with the basic yield and support effects.
 func NewStructureCard(cardID CardID, yieldAdditiveValue ResourceQuantity, yieldModifier ResourceModifier, supportPower float64, supportCardSlot int) *StructureCard {
+	return NewStructureCardWithEffects(cardID,
+		&StructureEffectYieldAdditive{Yield: yieldAdditiveValue},
+		&StructureEffectYieldModifier{Modifier: yieldModifier},
+		&StructureEffectSupport{Power: supportPower, CardSlot: supportCardSlot},
+	)
+}
+
+// NewStructureCardWithEffects creates a new StructureCard instance with the given effects.
+func NewStructureCardWithEffects(cardID CardID, effects ...StructureEffect) *StructureCard {
 	return &StructureCard{
-		cardID:             cardID,
-		yieldAdditiveValue: yieldAdditiveValue,
-		yieldModifier:      yieldModifier,
-		supportPower:       supportPower,
-		supportCardSlot:    supportCardSlot,
+		cardID:  cardID,
+		effects: effects,
 	}
 }
 
@@ -119,24 +122,53 @@ func (c *StructureCard) ID() CardID {
 	return c.cardID
 }
 
-// YieldAdditiveValue returns the direct additive yield bonus.
-func (c *StructureCard) YieldAdditiveValue() ResourceQuantity {
-	return c.yieldAdditiveValue
+// DescriptionKey returns the lang key of the description of the card.
+func (c *StructureCard) DescriptionKey() string {
+	return string(c.cardID) + "-desc"
 }
 
-// YieldModifier returns the multiplicative yield modifier.
-func (c *StructureCard) YieldModifier() ResourceModifier {
-	return c.yieldModifier
+// Effects returns the effects of the card.
+func (c *StructureCard) Effects() []StructureEffect {
+	return c.effects
+}
+
+// AddYield applies the additive yield effects of the card.
+func (c *StructureCard) AddYield(yield ResourceQuantity) ResourceQuantity {
+	for _, effect := range c.effects {
+		yield = effect.AddYield(yield)
+	}
+	return yield
 }
 
-// SupportPower returns the support power provided to battlefield.
+// ModifyYield applies the multiplicative yield effects of the card.
+func (c *StructureCard) ModifyYield(yield ResourceQuantity) ResourceQuantity {
+	for _, effect := range c.effects {
+		yield = effect.ModifyYield(yield)
+	}
+	return yield
+}
+
+// SupportPower returns the support power provided to adjacent battlefields.
 func (c *StructureCard) SupportPower() float64 {
-	return c.supportPower
+	return c.SupportPowerAt(1)
+}
+
+// SupportPowerAt returns the support power provided to a battlefield at the given graph distance.
+func (c *StructureCard) SupportPowerAt(distance int) float64 {
+	supportPower := 0.0
+	for _, effect := range c.effects {
+		supportPower += effect.SupportPowerAt(distance)
+	}
+	return supportPower
 }
 
 // SupportCardSlot returns the additional card slots provided to battlefield.
 func (c *StructureCard) SupportCardSlot() int {
-	return c.supportCardSlot
+	supportCardSlot := 0
+	for _, effect := range c.effects {
+		supportCardSlot += effect.SupportCardSlot()
+	}
+	return supportCardSlot
 }
 
 // CardDictionary is a struct for generating cards.
@@ -174,7 +206,8 @@ func (d *CardDictionary) StructureCard(cardID CardID) (*StructureCard, bool) {
 
 // CardDeck is the player's card deck.
 type CardDeck struct {
-	hand map[CardID]int
+	hand    map[CardID]int
+	version int
 }
 
 // NewCardDeck creates a new CardDeck instance.
@@ -185,6 +218,7 @@ func NewCardDeck() *CardDeck {
 }
 
 func (cd *CardDeck) ApplyDelta(delta map[CardID]int) {
+	cd.version++
 	for cardID, count := range delta {
 		cd.hand[cardID] += count
 		if cd.hand[cardID] <= 0 {
@@ -195,6 +229,7 @@ func (cd *CardDeck) ApplyDelta(delta map[CardID]int) {
 
 // Add adds a card to the deck by CardID.
 func (cd *CardDeck) Add(cardID CardID) {
+	cd.version++
 	cd.hand[cardID]++
 }
 
@@ -204,6 +239,7 @@ func (cd *CardDeck) Remove(cardID CardID) bool {
 	if cd.hand[cardID] <= 0 {
 		return false
 	}
+	cd.version++
 	cd.hand[cardID]--
 	if cd.hand[cardID] == 0 {
 		delete(cd.hand, cardID)
@@ -211,6 +247,11 @@ func (cd *CardDeck) Remove(cardID CardID) bool {
 	return true
 }
 
+// Version returns a number which changes whenever the deck changes, so views can tell when to rebuild.
+func (cd *CardDeck) Version() int {
+	return cd.version
+}
+
 // Count returns the number of cards with the given CardID in the deck.
 func (cd *CardDeck) Count(cardID CardID) int {
 	return cd.hand[cardID]
@@ -243,11 +284,34 @@ func (cd *CardDeck) GetAllCardCounts() map[CardID]int {
 // BattleCardSkillID is the identifier for a battle card skill.
 type BattleCardSkillID string
 
+// BattleCardSkillTiming determines in which rounds of a battle a BattleCardSkill takes effect.
+type BattleCardSkillTiming int
+
+const (
+	BattleCardSkillTimingAlways     BattleCardSkillTiming = iota // The skill takes effect in every round.
+	BattleCardSkillTimingFirstRound                              // The skill takes effect only in the first round.
+	BattleCardSkillTimingLaterRound                              // The skill takes effect from the second round on.
+)
+
+// IsActive returns true if the skill takes effect in the given round.
+func (t BattleCardSkillTiming) IsActive(round int) bool {
+	switch t {
+	case BattleCardSkillTimingFirstRound:
+		return round <= 1
+	case BattleCardSkillTimingLaterRound:
+		return round > 1
+	default:
+		return true
+	}
+}
+
 // BattleCardSkill is a skill for a battle card.
 type BattleCardSkill struct {
 	BattleCardSkillID BattleCardSkillID
 	DescriptionKey    string
 	Calculator        BattleCardSkillCalculator
+	Timing            BattleCardSkillTiming // Timing determines in which rounds the skill takes effect.
+	SurvivalRateBonus float64               // SurvivalRateBonus is added to the survival rate of the card after a battle.
 }
 
 func (s *BattleCardSkill) Calculate(options *BattleCardSkillCalculationOptions) {
@@ -264,6 +328,7 @@ type BattleCardSkillCalculationOptions struct {
 	BattleCards              []*BattleCard
 	BattleCardPowerModifiers []*BattleCardPowerModifier
 	Enemy                    *Enemy
+	Round                    int // Round is the current round of the battle, starting from 1.
 }
 
 type BattleCardSkillCalculationFunc func(options *BattleCardSkillCalculationOptions)
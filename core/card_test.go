@@ -376,6 +376,32 @@ func TestCardDeck_Count(t *testing.T) {
 	}
 }
 
+func TestCardDeck_Version(t *testing.T) {
+	deck := core.NewCardDeck()
+
+	version := deck.Version()
+	changed := func(name string) {
+		t.Helper()
+		if deck.Version() == version {
+			t.Errorf("Version() did not change after %s", name)
+		}
+		version = deck.Version()
+	}
+
+	deck.Add("card1")
+	changed("Add")
+	deck.Remove("card1")
+	changed("Remove")
+	deck.ApplyDelta(map[core.CardID]int{"card2": 2})
+	changed("ApplyDelta")
+
+	deck.Remove("card1")
+	deck.Count("card2")
+	if deck.Version() != version {
+		t.Errorf("Version() changed without a change of the deck")
+	}
+}
+
 func TestCardDeck_GetAllCardIDs(t *testing.T) {
 	tests := []struct {
 		name     string
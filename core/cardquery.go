@@ -0,0 +1,119 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+)
+
+// CardKind selects battle cards, structure cards or both.
+type CardKind int
+
+const (
+	CardKindAll CardKind = iota
+	CardKindBattle
+	CardKindStructure
+)
+
+// CardSort is the order of the cards in the deck view.
+type CardSort int
+
+const (
+	CardSortDefault CardSort = iota // The display order of the game
+	CardSortPower                   // Stronger battle cards first
+	CardSortCount                   // More copies first
+	CardSortName                    // Alphabetical by the name
+)
+
+// CardQuery filters and sorts the cards in a CardDeck for display.
+type CardQuery struct {
+	Kind CardKind
+	Type BattleCardType // Type selects only the battle cards of the type. Empty means all types.
+	Sort CardSort
+	// NameOf returns the display name used by CardSortName. The CardID is used if it is nil.
+	NameOf func(CardID) string
+}
+
+// Matches returns true if the card passes the filters of the query.
+func (q CardQuery) Matches(dictionary *CardDictionary, cardID CardID) bool {
+	battleCard, isBattle := dictionary.BattleCard(cardID)
+	switch q.Kind {
+	case CardKindBattle:
+		if !isBattle {
+			return false
+		}
+	case CardKindStructure:
+		if _, ok := dictionary.StructureCard(cardID); !ok {
+			return false
+		}
+	}
+	if q.Type != "" && (!isBattle || battleCard.Type != q.Type) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the IDs of the cards in the deck which match the query, sorted.
+// order is the display order of the game. It breaks ties, and cards missing from it come last by ID.
+func (q CardQuery) Apply(deck *CardDeck, dictionary *CardDictionary, order []CardID) []CardID {
+	rank := make(map[CardID]int, len(order))
+	for i, cardID := range order {
+		rank[cardID] = i
+	}
+	rankOf := func(cardID CardID) int {
+		if r, ok := rank[cardID]; ok {
+			return r
+		}
+		return len(order)
+	}
+
+	var result []CardID
+	for cardID, count := range deck.hand {
+		if count > 0 && q.Matches(dictionary, cardID) {
+			result = append(result, cardID)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b CardID) int {
+		if c := q.compare(deck, dictionary, a, b); c != 0 {
+			return c
+		}
+		return cmp.Or(cmp.Compare(rankOf(a), rankOf(b)), cmp.Compare(a, b))
+	})
+	return result
+}
+
+func (q CardQuery) compare(deck *CardDeck, dictionary *CardDictionary, a, b CardID) int {
+	switch q.Sort {
+	case CardSortPower:
+		return -cmp.Compare(cardPower(dictionary, a), cardPower(dictionary, b))
+	case CardSortCount:
+		return -cmp.Compare(deck.Count(a), deck.Count(b))
+	case CardSortName:
+		nameOf := q.NameOf
+		if nameOf == nil {
+			nameOf = func(cardID CardID) string { return string(cardID) }
+		}
+		return cmp.Compare(nameOf(a), nameOf(b))
+	}
+	return 0
+}
+
+// cardPower returns the power of a battle card. Structure cards have no power, so they come after any battle card.
+func cardPower(dictionary *CardDictionary, cardID CardID) BattleCardPower {
+	if card, ok := dictionary.BattleCard(cardID); ok {
+		return card.Power()
+	}
+	return -1
+}
+
+// BattleCardTypes returns the types of all battle cards in the dictionary, sorted.
+func (d *CardDictionary) BattleCardTypes() []BattleCardType {
+	var types []BattleCardType
+	for _, card := range d.battleCards {
+		if card.Type != "" && !slices.Contains(types, card.Type) {
+			types = append(types, card.Type)
+		}
+	}
+	slices.Sort(types)
+	return types
+}
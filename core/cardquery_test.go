@@ -0,0 +1,95 @@
+package core_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestCardQuery_Apply(t *testing.T) {
+	dictionary := core.NewCardDictionary(
+		[]*core.BattleCard{
+			core.NewBattleCard("knight", 8, nil, "cardtype-str"),
+			core.NewBattleCard("archer", 5, nil, "cardtype-agi"),
+			core.NewBattleCard("wizard", 12, nil, "cardtype-mag"),
+			core.NewBattleCard("unused", 99, nil, "cardtype-mag"),
+		},
+		[]*core.StructureCard{
+			core.NewStructureCard("farm", core.ResourceQuantity{Food: 1}, core.ResourceModifier{}, 0, 0),
+		},
+	)
+	order := []core.CardID{"wizard", "farm", "knight", "archer", "unused"}
+
+	deck := core.NewCardDeck()
+	deck.ApplyDelta(map[core.CardID]int{"knight": 1, "archer": 3, "wizard": 2, "farm": 2})
+
+	names := map[core.CardID]string{"knight": "Knight", "archer": "Archer", "wizard": "Wizard", "farm": "Farm"}
+
+	tests := []struct {
+		name  string
+		query core.CardQuery
+		want  []core.CardID
+	}{
+		{
+			name:  "default order shows only owned cards",
+			query: core.CardQuery{},
+			want:  []core.CardID{"wizard", "farm", "knight", "archer"},
+		},
+		{
+			name:  "battle cards",
+			query: core.CardQuery{Kind: core.CardKindBattle},
+			want:  []core.CardID{"wizard", "knight", "archer"},
+		},
+		{
+			name:  "structure cards",
+			query: core.CardQuery{Kind: core.CardKindStructure},
+			want:  []core.CardID{"farm"},
+		},
+		{
+			name:  "battle card type",
+			query: core.CardQuery{Type: "cardtype-agi"},
+			want:  []core.CardID{"archer"},
+		},
+		{
+			name:  "power puts structure cards last",
+			query: core.CardQuery{Sort: core.CardSortPower},
+			want:  []core.CardID{"wizard", "knight", "archer", "farm"},
+		},
+		{
+			name:  "count breaks ties by the display order",
+			query: core.CardQuery{Sort: core.CardSortCount},
+			want:  []core.CardID{"archer", "wizard", "farm", "knight"},
+		},
+		{
+			name:  "name",
+			query: core.CardQuery{Sort: core.CardSortName, NameOf: func(id core.CardID) string { return names[id] }},
+			want:  []core.CardID{"archer", "farm", "knight", "wizard"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.query.Apply(deck, dictionary, order)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardDictionary_BattleCardTypes(t *testing.T) {
+	dictionary := core.NewCardDictionary(
+		[]*core.BattleCard{
+			core.NewBattleCard("wizard", 12, nil, "cardtype-mag"),
+			core.NewBattleCard("knight", 8, nil, "cardtype-str"),
+			core.NewBattleCard("witch", 10, nil, "cardtype-mag"),
+		},
+		nil,
+	)
+
+	want := []core.BattleCardType{"cardtype-mag", "cardtype-str"}
+	if got := dictionary.BattleCardTypes(); !slices.Equal(got, want) {
+		t.Errorf("BattleCardTypes() = %v, want %v", got, want)
+	}
+}
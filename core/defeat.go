@@ -0,0 +1,61 @@
+package core
+
+// DefeatCondition decides whether the player has lost the game.
+type DefeatCondition interface {
+	// IsDefeated returns true if the player has lost the game.
+	IsDefeated(gameState *GameState) bool
+	// ReasonKey returns the lang key describing why the player lost.
+	ReasonKey() string
+}
+
+// DefeatConditionTurnLimit is met when the Demon Lord fully awakens at the turn limit.
+type DefeatConditionTurnLimit struct {
+	Limit Turn
+}
+
+func (c *DefeatConditionTurnLimit) IsDefeated(gameState *GameState) bool {
+	return gameState.CurrentTurn >= c.Limit
+}
+
+func (c *DefeatConditionTurnLimit) ReasonKey() string {
+	return "defeat-turn-limit"
+}
+
+// RemainingTurns returns the number of turns left before the turn limit.
+func (c *DefeatConditionTurnLimit) RemainingTurns(gameState *GameState) int {
+	remaining := int(c.Limit - gameState.CurrentTurn)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// DefeatConditionCapitalLost is met when a MyNationPoint has fallen.
+type DefeatConditionCapitalLost struct{}
+
+func (c *DefeatConditionCapitalLost) IsDefeated(gameState *GameState) bool {
+	for _, point := range gameState.MapGrid.Points {
+		if myNationPoint, ok := point.(*MyNationPoint); ok && myNationPoint.Fallen() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *DefeatConditionCapitalLost) ReasonKey() string {
+	return "defeat-capital-lost"
+}
+
+// DefeatConditionBankruptcy is met when the money in the treasury falls below MinMoney.
+// The treasury can fall into debt by paying the upkeep of the StructureCards.
+type DefeatConditionBankruptcy struct {
+	MinMoney int
+}
+
+func (c *DefeatConditionBankruptcy) IsDefeated(gameState *GameState) bool {
+	return gameState.Treasury.Resources.Money < c.MinMoney
+}
+
+func (c *DefeatConditionBankruptcy) ReasonKey() string {
+	return "defeat-bankruptcy"
+}
@@ -0,0 +1,116 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestGameState_Defeat(t *testing.T) {
+	tests := []struct {
+		name       string
+		turn       core.Turn
+		money      int
+		fallen     bool
+		expected   bool
+		wantReason string
+	}{
+		{
+			name:     "Not defeated",
+			turn:     10,
+			money:    5,
+			expected: false,
+		},
+		{
+			name:       "Turn limit reached",
+			turn:       48,
+			money:      5,
+			expected:   true,
+			wantReason: "defeat-turn-limit",
+		},
+		{
+			name:       "Capital lost",
+			turn:       10,
+			money:      5,
+			fallen:     true,
+			expected:   true,
+			wantReason: "defeat-capital-lost",
+		},
+		{
+			name:       "Bankruptcy",
+			turn:       10,
+			money:      -1,
+			expected:   true,
+			wantReason: "defeat-bankruptcy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			myNationPoint := &core.MyNationPoint{MyNation: core.NewMyNation("player", "Player Nation")}
+			if tt.fallen {
+				myNationPoint.Fall()
+			}
+
+			gameState := &core.GameState{
+				MapGrid: &core.MapGrid{
+					Size:   core.MapGridSize{X: 1, Y: 1},
+					Points: []core.Point{myNationPoint},
+				},
+				Treasury:    &core.Treasury{Resources: core.ResourceQuantity{Money: tt.money}},
+				CurrentTurn: tt.turn,
+				DefeatConditions: []core.DefeatCondition{
+					&core.DefeatConditionTurnLimit{Limit: 48},
+					&core.DefeatConditionCapitalLost{},
+					&core.DefeatConditionBankruptcy{MinMoney: 0},
+				},
+			}
+
+			if got := gameState.IsDefeat(); got != tt.expected {
+				t.Errorf("IsDefeat() = %v, want %v", got, tt.expected)
+			}
+			if condition, ok := gameState.Defeat(); ok && condition.ReasonKey() != tt.wantReason {
+				t.Errorf("ReasonKey() = %v, want %v", condition.ReasonKey(), tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestGameState_BankruptcyByUpkeep(t *testing.T) {
+	territory := core.NewTerritory("territory", core.NewTerrain("terrain", core.ResourceQuantity{}, 3))
+	plan := core.NewConstructionPlan(territory)
+	for _, id := range []core.CardID{"a", "b"} {
+		plan.AddCard(core.NewStructureCard(id, core.ResourceQuantity{}, core.NewResourceModifier(), 0.0, 0))
+	}
+	territory.ApplyConstructionPlan(plan)
+
+	wilderness := &core.WildernessPoint{}
+	wilderness.SetControlledForTest(true)
+	wilderness.SetTerritoryForTest(territory)
+
+	gameState := &core.GameState{
+		MapGrid: &core.MapGrid{
+			Size:   core.MapGridSize{X: 1, Y: 1},
+			Points: []core.Point{wilderness},
+		},
+		Treasury: &core.Treasury{Resources: core.ResourceQuantity{Money: 3}},
+		Upkeep:   core.ResourceQuantity{Money: 1},
+		DefeatConditions: []core.DefeatCondition{
+			&core.DefeatConditionBankruptcy{MinMoney: 0},
+		},
+	}
+
+	if got, want := gameState.GetUpkeep(), (core.ResourceQuantity{Money: 2}); got != want {
+		t.Errorf("GetUpkeep() = %v, want %v", got, want)
+	}
+
+	for i, want := range []int{1, -1} {
+		gameState.NextTurn(&MockIntner{})
+		if got := gameState.Treasury.Resources.Money; got != want {
+			t.Errorf("turn %d: Money = %d, want %d", i+1, got, want)
+		}
+	}
+	if condition, ok := gameState.Defeat(); !ok || condition.ReasonKey() != "defeat-bankruptcy" {
+		t.Errorf("Defeat() = %v, %v, want defeat-bankruptcy", condition, ok)
+	}
+}
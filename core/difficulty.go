@@ -0,0 +1,14 @@
+package core
+
+// DifficultyID is the identifier of a Difficulty.
+type DifficultyID string
+
+// Difficulty is the difficulty level of a run. The multipliers are applied when the game is loaded.
+type Difficulty struct {
+	ID                   DifficultyID
+	EnemyPowerMultiplier float64          // EnemyPowerMultiplier is applied to the power of every Enemy.
+	YieldMultiplier      float64          // YieldMultiplier is applied to the base yield of every Terrain.
+	PriceMultiplier      float64          // PriceMultiplier is applied to the price of every MarketItem.
+	TreasuryBonus        ResourceQuantity // TreasuryBonus is added to the starting Treasury, which is otherwise empty.
+	ScoreMultiplier      float64          // ScoreMultiplier is applied to the score of the run.
+}
@@ -34,6 +34,11 @@ func (e *Enemy) ID() EnemyID {
 	return e.id
 }
 
+// TalkKey returns the lang key of the dialogue of the enemy.
+func (e *Enemy) TalkKey() string {
+	return string(e.id) + "-talk"
+}
+
 // Type returns the enemy type.
 func (e *Enemy) Type() EnemyType {
 	return e.enemyType
@@ -58,17 +63,33 @@ func (e *Enemy) BattleCardSlot() int {
 
 // EnemySkill represents an enemy skill.
 type EnemySkill struct {
-	id        EnemySkillID
-	condition func(idx int, options *EnemySkillCalculationOptions) bool
-	modifier  *BattleCardPowerModifier
+	id         EnemySkillID
+	interval   int // The skill triggers every interval rounds, starting from the first round.
+	calculator EnemySkillCalculator
 }
 
-// NewEnemySkill creates a new EnemySkill instance.
+// NewEnemySkill creates a new EnemySkill instance that applies modifier to the BattleCards matching condition in every round.
 func NewEnemySkill(id EnemySkillID, condition func(idx int, options *EnemySkillCalculationOptions) bool, modifier *BattleCardPowerModifier) *EnemySkill {
+	return NewIntervalEnemySkill(id, 1, condition, modifier)
+}
+
+// NewIntervalEnemySkill creates a new EnemySkill instance that applies modifier to the BattleCards matching condition every interval rounds.
+func NewIntervalEnemySkill(id EnemySkillID, interval int, condition func(idx int, options *EnemySkillCalculationOptions) bool, modifier *BattleCardPowerModifier) *EnemySkill {
+	return NewEnemySkillWithCalculator(id, interval, &EnemySkillCalculatorModifier{
+		Condition: condition,
+		Modifier:  modifier,
+	})
+}
+
+// NewEnemySkillWithCalculator creates a new EnemySkill instance with any EnemySkillCalculator.
+func NewEnemySkillWithCalculator(id EnemySkillID, interval int, calculator EnemySkillCalculator) *EnemySkill {
+	if interval < 1 {
+		interval = 1
+	}
 	return &EnemySkill{
-		id:        id,
-		condition: condition,
-		modifier:  modifier,
+		id:         id,
+		interval:   interval,
+		calculator: calculator,
 	}
 }
 
@@ -77,19 +98,150 @@ func (s *EnemySkill) ID() EnemySkillID {
 	return s.id
 }
 
+// Interval returns the number of rounds between triggers.
+func (s *EnemySkill) Interval() int {
+	return s.interval
+}
+
+// DescriptionKey returns the lang key of the description of the skill.
+func (s *EnemySkill) DescriptionKey() string {
+	return string(s.id) + "-desc"
+}
+
+// TriggersInRound returns true if the skill triggers in the given round.
+func (s *EnemySkill) TriggersInRound(round int) bool {
+	return (round-1)%s.interval == 0
+}
+
+// Prepare applies the enemy skill effects on the rules of the Battlefield. It is called once when the Battlefield is created.
+func (s *EnemySkill) Prepare(battlefield *Battlefield) {
+	s.calculator.Prepare(battlefield)
+}
+
 // Calculate applies the enemy skill effects.
 func (s *EnemySkill) Calculate(options *EnemySkillCalculationOptions) {
+	s.calculator.Calculate(options)
+}
+
+// EnemySkillCalculator is the effect of an EnemySkill on the whole Battlefield.
+type EnemySkillCalculator interface {
+	// Prepare changes the rules of the Battlefield, such as CardSlot. It is called once when the Battlefield is created.
+	Prepare(battlefield *Battlefield)
+	// Calculate changes the power calculation. It is called on every calculation.
+	Calculate(options *EnemySkillCalculationOptions)
+}
+
+type EnemySkillCalculationOptions struct {
+	Battlefield              *Battlefield
+	BattleCards              []*BattleCard
+	BattleCardPowerModifiers []*BattleCardPowerModifier
+	Enemy                    *Enemy
+	Round                    int     // Round is the current round of the battle, starting from 1.
+	NegateSupportPower       bool    // NegateSupportPower ignores the BaseSupportPower of the Battlefield.
+	EnemyPowerBonus          float64 // EnemyPowerBonus is added to the power needed to defeat the enemy.
+}
+
+// EnemySkillCalculationFunc is an EnemySkillCalculator that only changes the power calculation.
+type EnemySkillCalculationFunc func(options *EnemySkillCalculationOptions)
+
+func (f EnemySkillCalculationFunc) Prepare(battlefield *Battlefield) {}
+
+func (f EnemySkillCalculationFunc) Calculate(options *EnemySkillCalculationOptions) {
+	f(options)
+}
+
+type EnemySkillCalculatorComposite struct {
+	Calculators []EnemySkillCalculator
+}
+
+func (c *EnemySkillCalculatorComposite) Prepare(battlefield *Battlefield) {
+	for _, calculator := range c.Calculators {
+		calculator.Prepare(battlefield)
+	}
+}
+
+func (c *EnemySkillCalculatorComposite) Calculate(options *EnemySkillCalculationOptions) {
+	for _, calculator := range c.Calculators {
+		calculator.Calculate(options)
+	}
+}
+
+// EnemySkillCalculatorModifier applies Modifier to the BattleCards matching Condition.
+type EnemySkillCalculatorModifier struct {
+	Condition func(idx int, options *EnemySkillCalculationOptions) bool
+	Modifier  *BattleCardPowerModifier
+}
+
+func (c *EnemySkillCalculatorModifier) Prepare(battlefield *Battlefield) {}
+
+func (c *EnemySkillCalculatorModifier) Calculate(options *EnemySkillCalculationOptions) {
 	for i := range options.BattleCards {
-		if !s.condition(i, options) {
+		if !c.Condition(i, options) {
 			continue
 		}
 		modifier := options.BattleCardPowerModifiers[i]
-		modifier.Union(s.modifier)
+		modifier.Union(c.Modifier)
 	}
 }
 
-type EnemySkillCalculationOptions struct {
-	BattleCards              []*BattleCard
-	BattleCardPowerModifiers []*BattleCardPowerModifier
-	Enemy                    *Enemy
+// EnemySkillCalculatorCardSlot changes the CardSlot of the Battlefield. The CardSlot never goes below 1.
+type EnemySkillCalculatorCardSlot struct {
+	Delta int
+}
+
+func (c *EnemySkillCalculatorCardSlot) Prepare(battlefield *Battlefield) {
+	battlefield.CardSlot += c.Delta
+	if battlefield.CardSlot < 1 {
+		battlefield.CardSlot = 1
+	}
+}
+
+func (c *EnemySkillCalculatorCardSlot) Calculate(options *EnemySkillCalculationOptions) {}
+
+// EnemySkillCalculatorNegateSupportPower ignores the BaseSupportPower of the Battlefield.
+type EnemySkillCalculatorNegateSupportPower struct{}
+
+func (c *EnemySkillCalculatorNegateSupportPower) Prepare(battlefield *Battlefield) {}
+
+func (c *EnemySkillCalculatorNegateSupportPower) Calculate(options *EnemySkillCalculationOptions) {
+	options.NegateSupportPower = true
+}
+
+// EnemySkillCalculatorForbidCardType forbids placing BattleCards of CardType.
+type EnemySkillCalculatorForbidCardType struct {
+	CardType BattleCardType
+}
+
+func (c *EnemySkillCalculatorForbidCardType) Prepare(battlefield *Battlefield) {
+	battlefield.ForbiddenCardTypes = append(battlefield.ForbiddenCardTypes, c.CardType)
+}
+
+func (c *EnemySkillCalculatorForbidCardType) Calculate(options *EnemySkillCalculationOptions) {}
+
+// EnemySkillCalculatorCopyStrongest adds the base power of the strongest BattleCard, multiplied by Ratio, to the enemy.
+type EnemySkillCalculatorCopyStrongest struct {
+	Ratio float64
+}
+
+func (c *EnemySkillCalculatorCopyStrongest) Prepare(battlefield *Battlefield) {}
+
+func (c *EnemySkillCalculatorCopyStrongest) Calculate(options *EnemySkillCalculationOptions) {
+	strongest := 0.0
+	for _, card := range options.BattleCards {
+		if power := float64(card.Power()); power > strongest {
+			strongest = power
+		}
+	}
+	options.EnemyPowerBonus += strongest * c.Ratio
+}
+
+// EnemySkillCalculatorPowerPerCard adds PowerPerCard to the enemy for each BattleCard played.
+type EnemySkillCalculatorPowerPerCard struct {
+	PowerPerCard float64
+}
+
+func (c *EnemySkillCalculatorPowerPerCard) Prepare(battlefield *Battlefield) {}
+
+func (c *EnemySkillCalculatorPowerPerCard) Calculate(options *EnemySkillCalculationOptions) {
+	options.EnemyPowerBonus += c.PowerPerCard * float64(len(options.BattleCards))
 }
@@ -0,0 +1,41 @@
+package core
+
+// EventType is the type of an Event.
+type EventType string
+
+const (
+	EventTypeBattleWon             EventType = "battle-won"             // A battle is won.
+	EventTypeBattleLost            EventType = "battle-lost"            // A battle is lost.
+	EventTypeConstructionCommitted EventType = "construction-committed" // A ConstructionPlan is applied to a Territory.
+	EventTypePackOpened            EventType = "pack-opened"            // A CardPack is opened.
+	EventTypeVictory               EventType = "victory"                // All bosses are defeated.
+	EventTypeCardPlayed            EventType = "card-played"            // A card is played from the deck to a battlefield or a ConstructionPlan.
+	EventTypeTurnEnded             EventType = "turn-ended"             // A turn ends. Turn is the turn that ended.
+)
+
+// Event is something that happened in the game. Only the fields related to the Type are set.
+type Event struct {
+	Type          EventType
+	Turn          Turn
+	Enemy         *Enemy         // Enemy is the opponent of a battle.
+	BattleCards   []*BattleCard  // BattleCards are all the BattleCards played in a battle, or the played BattleCard.
+	StructureCard *StructureCard // StructureCard is the played StructureCard.
+	Territory     *Territory     // Territory is the Territory of a construction.
+	CardPack      *CardPack      // CardPack is the opened CardPack.
+}
+
+// EventListener is called when an Event happens.
+type EventListener func(event *Event, gameState *GameState)
+
+// Subscribe registers listener to be called on every Event.
+func (g *GameState) Subscribe(listener EventListener) {
+	g.listeners = append(g.listeners, listener)
+}
+
+// Notify sets the current turn to event and calls all the listeners.
+func (g *GameState) Notify(event *Event) {
+	event.Turn = g.CurrentTurn
+	for _, listener := range g.listeners {
+		listener(event, g)
+	}
+}
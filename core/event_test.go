@@ -0,0 +1,25 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestGameState_NextTurnEvent(t *testing.T) {
+	gameState := &core.GameState{
+		Treasury:    &core.Treasury{},
+		MapGrid:     &core.MapGrid{Size: core.MapGridSize{X: 1, Y: 1}, Points: []core.Point{&core.MyNationPoint{}}},
+		CurrentTurn: 3,
+	}
+	var events []*core.Event
+	gameState.Subscribe(func(event *core.Event, gameState *core.GameState) {
+		events = append(events, event)
+	})
+
+	gameState.NextTurn(&MockIntner{})
+
+	if len(events) != 1 || events[0].Type != core.EventTypeTurnEnded || events[0].Turn != 3 {
+		t.Errorf("events = %v, want a turn-ended event of turn 3", events)
+	}
+}
@@ -8,21 +8,31 @@ type GameState struct {
 	Treasury                *Treasury            // Player's treasury
 	CurrentTurn             Turn                 // Current turn number
 	CardDictionary          *CardDictionary      // Card generator
-	Histories               []History            // History of events
+	Histories               Histories            // History of events
 	Markets                 map[NationID]*Market // Markets for each nation
 	CardDisplayOrder        []CardID             // Card display order for stable UI rendering
+	DefeatConditions        []DefeatCondition    // Conditions under which the player loses the game
+	Stats                   GameStats            // Statistics of the run
+	CapitalDefense          int                  // Number of lost boss battles the capital withstands before it falls
+	Upkeep                  ResourceQuantity     // Resources paid each turn for every StructureCard in the controlled Territories
+	Difficulty              *Difficulty          // Difficulty of the run
+	Seed                    int64                // Seed of the random number generator of the run
 	currentBattlefield      *Battlefield
+	battleX, battleY        int // Coordinates of the current battle
 	currentConstructionPlan *ConstructionPlan
+	listeners               []EventListener
 }
 
 func (g *GameState) GetYield() ResourceQuantity {
 	totalYield := ResourceQuantity{} // Start with zero yield
 
-	// Add the Yield of the Territory of the controlled WildernessPoint
-	for _, point := range g.MapGrid.Points {
+	// Add the Yield of the Territory of the controlled WildernessPoint, including the synergies with the neighbours
+	for i, point := range g.MapGrid.Points {
 		if wilderness, ok := point.(*WildernessPoint); ok && wilderness.controlled {
 			if wilderness.territory != nil {
+				x, y := g.MapGrid.Size.XY(i)
 				totalYield = totalYield.Add(wilderness.territory.Yield())
+				totalYield = totalYield.Add(g.MapGrid.SynergyBonus(x, y))
 			}
 		}
 	}
@@ -35,10 +45,63 @@ func (g *GameState) AddYield() {
 	g.Treasury.Add(g.GetYield())
 }
 
-// NextTurn advances the turn and adds Yield.
-func (g *GameState) NextTurn() {
+// GetUpkeep returns the Upkeep of all StructureCards in the controlled Territories.
+func (g *GameState) GetUpkeep() ResourceQuantity {
+	total := ResourceQuantity{}
+	for _, point := range g.MapGrid.Points {
+		if wilderness, ok := point.(*WildernessPoint); ok && wilderness.controlled && wilderness.territory != nil {
+			for range wilderness.territory.Cards() {
+				total = total.Add(g.Upkeep)
+			}
+		}
+	}
+	return total
+}
+
+// PayUpkeep pays the Upkeep of the StructureCards from the Treasury. The Treasury can fall into debt.
+func (g *GameState) PayUpkeep() {
+	g.Treasury.Pay(g.GetUpkeep())
+}
+
+// NextTurn advances the turn, adds Yield, pays Upkeep, develops the held Territories and triggers the turn start effects of the StructureCards.
+func (g *GameState) NextTurn(intner Intner) {
+	g.AddHistory(NewTurnEndedHistory(g.CurrentTurn, g.GetYield()))
+	g.Notify(&Event{Type: EventTypeTurnEnded})
 	g.CurrentTurn++
 	g.AddYield()
+	g.PayUpkeep()
+
+	for _, point := range g.MapGrid.Points {
+		if point == nil {
+			continue
+		}
+		if territoryPoint, ok := point.AsTerritoryPoint(); ok {
+			territoryPoint.Territory().Hold()
+		}
+	}
+
+	for i := range g.MapGrid.Points {
+		x, y := g.MapGrid.Size.XY(i)
+		g.triggerStructureEffects(x, y, intner, func(ctx *StructureEffectContext, effect StructureEffect) {
+			effect.OnTurnStart(ctx)
+		})
+	}
+}
+
+// Defeat returns the first DefeatCondition that is met.
+func (g *GameState) Defeat() (DefeatCondition, bool) {
+	for _, condition := range g.DefeatConditions {
+		if condition.IsDefeated(g) {
+			return condition, true
+		}
+	}
+	return nil, false
+}
+
+// IsDefeat determines whether any of the DefeatConditions is met.
+func (g *GameState) IsDefeat() bool {
+	_, ok := g.Defeat()
+	return ok
 }
 
 // IsVictory determines the victory condition (whether all BossPoints have been defeated).
@@ -63,12 +126,51 @@ func (g *GameState) AddHistory(history History) {
 	g.Histories = append(g.Histories, history)
 }
 
-func (g *GameState) InitBattlefield(x, y int) bool {
-	battlefield, ok := g.MapGrid.CreateBattlefield(x, y)
+func (g *GameState) InitBattlefield(x, y int, intner Intner) bool {
+	battlefield, ok := g.createBattlefield(x, y, intner)
 	if !ok {
 		return false
 	}
 	g.currentBattlefield = battlefield
+	g.battleX, g.battleY = x, y
+	return true
+}
+
+// createBattlefield creates the Battlefield at (x, y) and triggers the effects of the adjacent StructureCards.
+func (g *GameState) createBattlefield(x, y int, intner Intner) (*Battlefield, bool) {
+	battlefield, ok := g.MapGrid.CreateBattlefield(x, y)
+	if !ok {
+		return nil, false
+	}
+	g.triggerAdjacentStructureEffects(x, y, intner, func(ctx *StructureEffectContext, effect StructureEffect) {
+		effect.OnBattlefieldCreated(ctx, battlefield)
+	})
+	return battlefield, true
+}
+
+// NextBossPhase moves the current battle to the next phase of the boss if the current phase can be beaten.
+// The BattleCards played so far are carried over to the new Battlefield and do not return to the CardDeck.
+// The battle is counted only when it is resolved. If the player retreats or loses, the boss recovers to its first phase.
+// It returns false if the battle is not against a boss, or if the current phase is the final one.
+func (g *GameState) NextBossPhase(intner Intner) bool {
+	battlefield := g.currentBattlefield
+	if battlefield == nil || !battlefield.CanBeat() {
+		return false
+	}
+
+	bossPoint, ok := battlefield.Point.(*BossPoint)
+	if !ok || bossPoint.IsFinalPhase() {
+		return false
+	}
+
+	bossPoint.Conquer()
+	next, ok := g.createBattlefield(g.battleX, g.battleY, intner)
+	if !ok {
+		bossPoint.Recover()
+		return false
+	}
+	next.UsedBattleCards = battlefield.AllBattleCards()
+	g.currentBattlefield = next
 	return true
 }
 
@@ -77,6 +179,81 @@ func (g *GameState) Conquer() {
 		return
 	}
 	g.currentBattlefield.Point.Conquer()
+	g.MapGrid.UpdateAccesibles()
+	g.currentBattlefield = nil
+}
+
+// ResolveBattle resolves the current battle. Surviving BattleCards are returned to the CardDeck.
+// The BattlePoint is conquered only on a victory.
+func (g *GameState) ResolveBattle(intner Intner) (*BattleResult, bool) {
+	if g.currentBattlefield == nil {
+		return nil, false
+	}
+
+	result := g.currentBattlefield.Resolve(intner)
+	g.triggerAdjacentStructureEffects(g.battleX, g.battleY, intner, func(ctx *StructureEffectContext, effect StructureEffect) {
+		effect.OnBattleResolved(ctx, result)
+	})
+	for _, card := range result.Survivors {
+		g.CardDeck.Add(card.CardID)
+	}
+
+	battlefield := g.currentBattlefield
+	g.currentBattlefield = nil
+
+	event := &Event{
+		Enemy:       battlefield.Enemy,
+		BattleCards: battlefield.AllBattleCards(),
+	}
+
+	if result.Victory {
+		g.Stats.BattlesWon++
+		if battlefield.Point != nil {
+			battlefield.Point.Conquer()
+			g.MapGrid.UpdateAccesibles()
+			g.triggerAdjacentStructureEffects(g.battleX, g.battleY, intner, func(ctx *StructureEffectContext, effect StructureEffect) {
+				effect.OnAdjacentConquered(ctx, g.battleX, g.battleY)
+			})
+		}
+		g.AddHistory(NewConquestHistory(g.CurrentTurn, battlefield.Enemy, battlefield.Terrain))
+		event.Type = EventTypeBattleWon
+		g.Notify(event)
+
+		if _, ok := battlefield.Point.(*BossPoint); ok && g.IsVictory() {
+			g.Notify(&Event{Type: EventTypeVictory})
+		}
+	} else {
+		g.Stats.BattlesLost++
+		if bossPoint, ok := battlefield.Point.(*BossPoint); ok {
+			// The Demon Lord counterattacks. The capital falls once it can no longer withstand the attacks.
+			bossPoint.Recover()
+			g.Stats.BossBattlesLost++
+			if g.Stats.BossBattlesLost > g.CapitalDefense {
+				g.MapGrid.FallCapital()
+			}
+		}
+		g.AddHistory(NewBattleLostHistory(g.CurrentTurn, battlefield.Enemy))
+		event.Type = EventTypeBattleLost
+		g.Notify(event)
+	}
+
+	return result, true
+}
+
+// RetreatBattle cancels the current battle.
+// Only the BattleCards of the current round return to the CardDeck. The ones played in finished rounds have already fought and are lost.
+func (g *GameState) RetreatBattle() {
+	battlefield := g.currentBattlefield
+	if battlefield == nil {
+		return
+	}
+
+	for _, card := range battlefield.BattleCards {
+		g.CardDeck.Add(card.CardID)
+	}
+	if bossPoint, ok := battlefield.Point.(*BossPoint); ok {
+		bossPoint.Recover()
+	}
 	g.currentBattlefield = nil
 }
 
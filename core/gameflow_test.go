@@ -88,7 +88,7 @@ func TestGameState_NextTurn(t *testing.T) {
 	initialTreasury := gameState.Treasury.Resources
 
 	// Advance turn
-	gameState.NextTurn()
+	gameState.NextTurn(&MockIntner{})
 
 	// Check if the turn has advanced
 	if gameState.CurrentTurn != initialTurn+1 {
@@ -256,3 +256,151 @@ func TestGameState_CanInteract(t *testing.T) {
 		})
 	}
 }
+
+func TestGameState_NextBossPhase(t *testing.T) {
+	phase1 := core.NewEnemy("final_boss", "dragon", 5.0, []*core.EnemySkill{}, 3)
+	phase2 := core.NewEnemy("final_boss", "dragon", 20.0, []*core.EnemySkill{}, 3)
+
+	bossPoint := core.NewBossPoint([]*core.BossPhase{{Enemy: phase1}, {Enemy: phase2}})
+
+	gameState := &core.GameState{
+		CardDeck: core.NewCardDeck(),
+		MapGrid: &core.MapGrid{
+			Size:   core.MapGridSize{X: 1, Y: 1},
+			Points: []core.Point{bossPoint},
+		},
+	}
+
+	if !gameState.InitBattlefield(0, 0, &MockIntner{}) {
+		t.Fatalf("InitBattlefield() failed")
+	}
+	battlefield, _ := gameState.Battlefield()
+	card := core.NewBattleCard("warrior", 6.0, nil, "warrior")
+	battlefield.AddBattleCard(card)
+
+	if !gameState.NextBossPhase(&MockIntner{}) {
+		t.Fatalf("NextBossPhase() = false, want true")
+	}
+
+	next, _ := gameState.Battlefield()
+	if next.Enemy != phase2 {
+		t.Errorf("Enemy = %v, want %v", next.Enemy, phase2)
+	}
+	if len(next.UsedBattleCards) != 1 || next.UsedBattleCards[0] != card {
+		t.Errorf("UsedBattleCards = %v, want the cards of the first phase", next.UsedBattleCards)
+	}
+	if gameState.CardDeck.Count("warrior") != 0 {
+		t.Errorf("used cards should not return to the deck")
+	}
+	if gameState.Stats.BattlesWon != 0 {
+		t.Errorf("BattlesWon = %d, want 0 until the battle is resolved", gameState.Stats.BattlesWon)
+	}
+
+	// The final phase resolves the battle normally.
+	if gameState.NextBossPhase(&MockIntner{}) {
+		t.Errorf("NextBossPhase() = true in the final phase, want false")
+	}
+}
+
+func TestGameState_RetreatBattle(t *testing.T) {
+	bossPoint := &core.BossPoint{}
+	bossPoint.SetBossForTest(core.NewEnemy("final_boss", "dragon", 100.0, []*core.EnemySkill{}, 3))
+
+	gameState := &core.GameState{
+		CardDeck: core.NewCardDeck(),
+		MapGrid: &core.MapGrid{
+			Size:   core.MapGridSize{X: 1, Y: 1},
+			Points: []core.Point{bossPoint},
+		},
+	}
+
+	if !gameState.InitBattlefield(0, 0, &MockIntner{}) {
+		t.Fatalf("InitBattlefield() failed")
+	}
+	battlefield, _ := gameState.Battlefield()
+	battlefield.AddBattleCard(core.NewBattleCard("warrior", 6.0, nil, "warrior"))
+	if !battlefield.Attack() {
+		t.Fatalf("Attack() = false, want true")
+	}
+	battlefield.AddBattleCard(core.NewBattleCard("archer", 4.0, nil, "archer"))
+
+	gameState.RetreatBattle()
+
+	if gameState.CardDeck.Count("warrior") != 0 {
+		t.Errorf("cards used in a finished round should not return to the deck")
+	}
+	if gameState.CardDeck.Count("archer") != 1 {
+		t.Errorf("Count(archer) = %d, want 1", gameState.CardDeck.Count("archer"))
+	}
+	if _, ok := gameState.Battlefield(); ok {
+		t.Errorf("Battlefield() should be cleared after retreating")
+	}
+}
+
+func TestGameState_RetreatAfterNextBossPhase(t *testing.T) {
+	phase1 := core.NewEnemy("final_boss", "dragon", 5.0, []*core.EnemySkill{}, 3)
+	phase2 := core.NewEnemy("final_boss", "dragon", 20.0, []*core.EnemySkill{}, 3)
+	bossPoint := core.NewBossPoint([]*core.BossPhase{{Enemy: phase1}, {Enemy: phase2}})
+
+	gameState := &core.GameState{
+		CardDeck: core.NewCardDeck(),
+		MapGrid: &core.MapGrid{
+			Size:   core.MapGridSize{X: 1, Y: 1},
+			Points: []core.Point{bossPoint},
+		},
+	}
+
+	if !gameState.InitBattlefield(0, 0, &MockIntner{}) {
+		t.Fatalf("InitBattlefield() failed")
+	}
+	battlefield, _ := gameState.Battlefield()
+	battlefield.AddBattleCard(core.NewBattleCard("warrior", 6.0, nil, "warrior"))
+	if !gameState.NextBossPhase(&MockIntner{}) {
+		t.Fatalf("NextBossPhase() = false, want true")
+	}
+	next, _ := gameState.Battlefield()
+	next.AddBattleCard(core.NewBattleCard("archer", 4.0, nil, "archer"))
+
+	gameState.RetreatBattle()
+
+	if bossPoint.Phase() != 0 || bossPoint.Enemy() != phase1 {
+		t.Errorf("Phase() = %d, want the boss to recover to the first phase", bossPoint.Phase())
+	}
+	if gameState.CardDeck.Count("warrior") != 0 {
+		t.Errorf("cards used in a beaten phase should not return to the deck")
+	}
+	if gameState.CardDeck.Count("archer") != 1 {
+		t.Errorf("Count(archer) = %d, want 1", gameState.CardDeck.Count("archer"))
+	}
+	if gameState.Stats.BattlesWon != 0 {
+		t.Errorf("BattlesWon = %d, want 0", gameState.Stats.BattlesWon)
+	}
+}
+
+func TestGameState_CapitalDefense(t *testing.T) {
+	myNationPoint := &core.MyNationPoint{MyNation: core.NewMyNation("player", "Player Nation")}
+	bossPoint := &core.BossPoint{}
+	bossPoint.SetBossForTest(core.NewEnemy("final_boss", "dragon", 100.0, []*core.EnemySkill{}, 3))
+
+	gameState := &core.GameState{
+		CardDeck: core.NewCardDeck(),
+		MapGrid: &core.MapGrid{
+			Size:   core.MapGridSize{X: 2, Y: 1},
+			Points: []core.Point{myNationPoint, bossPoint},
+		},
+		CapitalDefense: 1,
+	}
+
+	for i, wantFallen := range []bool{false, true} {
+		if !gameState.InitBattlefield(1, 0, &MockIntner{}) {
+			t.Fatalf("InitBattlefield() failed")
+		}
+		result, ok := gameState.ResolveBattle(&MockIntner{})
+		if !ok || result.Victory {
+			t.Fatalf("ResolveBattle() should be a defeat")
+		}
+		if myNationPoint.Fallen() != wantFallen {
+			t.Errorf("defeat %d: Fallen() = %v, want %v", i+1, myNationPoint.Fallen(), wantFallen)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// HistoryKind is the kind of a History.
+type HistoryKind string
+
+const (
+	HistoryKindMarketLevel  HistoryKind = "market-level"  // The level of a Market rises.
+	HistoryKindConquest     HistoryKind = "conquest"      // A point is conquered.
+	HistoryKindBattleLost   HistoryKind = "battle-lost"   // A battle is lost.
+	HistoryKindPurchase     HistoryKind = "purchase"      // A CardPack is purchased.
+	HistoryKindPackContents HistoryKind = "pack-contents" // The cards of an opened CardPack.
+	HistoryKindConstruction HistoryKind = "construction"  // A ConstructionPlan is applied to a Territory.
+	HistoryKindTurnEnded    HistoryKind = "turn-ended"    // A turn ends and the yield is added.
+)
+
+// History is an entry of the history log.
+// Key is the lang key of the text, and the string values in Data are lang keys to be translated.
+type History struct {
+	Turn Turn           `json:"turn"`
+	Kind HistoryKind    `json:"kind"`
+	Key  string         `json:"key"`
+	Data map[string]any `json:"data,omitempty"`
+}
+
+// NewMarketLevelHistory creates a History of the rise of a market level.
+func NewMarketLevelHistory(turn Turn, nationID NationID, level int) History {
+	return History{
+		Turn: turn,
+		Kind: HistoryKindMarketLevel,
+		Key:  "history-market",
+		Data: map[string]any{"nation": string(nationID), "level": level},
+	}
+}
+
+// NewConquestHistory creates a History of a conquest. terrain is nil for points without a Terrain such as bosses.
+func NewConquestHistory(turn Turn, enemy *Enemy, terrain *Terrain) History {
+	terrainKey := "point-boss"
+	if terrain != nil {
+		terrainKey = string(terrain.ID())
+	}
+	return History{
+		Turn: turn,
+		Kind: HistoryKindConquest,
+		Key:  "history-defeat",
+		Data: map[string]any{"enemy": string(enemy.ID()), "terrain": terrainKey},
+	}
+}
+
+// NewBattleLostHistory creates a History of a lost battle.
+func NewBattleLostHistory(turn Turn, enemy *Enemy) History {
+	return History{
+		Turn: turn,
+		Kind: HistoryKindBattleLost,
+		Key:  "history-battle-lost",
+		Data: map[string]any{"enemy": string(enemy.ID())},
+	}
+}
+
+// NewPurchaseHistory creates a History of a purchase of a CardPack.
+func NewPurchaseHistory(turn Turn, nationID NationID, cardPack *CardPack) History {
+	return History{
+		Turn: turn,
+		Kind: HistoryKindPurchase,
+		Key:  "history-purchase",
+		Data: map[string]any{"nation": string(nationID), "pack": string(cardPack.CardPackID)},
+	}
+}
+
+// NewPackContentsHistory creates a History of the cards of an opened CardPack.
+func NewPackContentsHistory(turn Turn, cardPack *CardPack, cardIDs []CardID) History {
+	return History{
+		Turn: turn,
+		Kind: HistoryKindPackContents,
+		Key:  "history-pack-contents",
+		Data: map[string]any{"pack": string(cardPack.CardPackID), "cards": cardIDStrings(cardIDs)},
+	}
+}
+
+// NewConstructionHistory creates a History of a construction in a Territory.
+func NewConstructionHistory(turn Turn, territory *Territory) History {
+	cards := territory.Cards()
+	cardIDs := make([]CardID, len(cards))
+	for i, card := range cards {
+		cardIDs[i] = card.ID()
+	}
+	return History{
+		Turn: turn,
+		Kind: HistoryKindConstruction,
+		Key:  "history-construction",
+		Data: map[string]any{"terrain": string(territory.Terrain().ID()), "cards": cardIDStrings(cardIDs)},
+	}
+}
+
+// NewTurnEndedHistory creates a History of the end of a turn with the yield added.
+func NewTurnEndedHistory(turn Turn, yield ResourceQuantity) History {
+	return History{
+		Turn: turn,
+		Kind: HistoryKindTurnEnded,
+		Key:  "history-turn-ended",
+		Data: map[string]any{
+			"money": yield.Money,
+			"food":  yield.Food,
+			"wood":  yield.Wood,
+			"iron":  yield.Iron,
+			"mana":  yield.Mana,
+		},
+	}
+}
+
+func cardIDStrings(cardIDs []CardID) []string {
+	result := make([]string, len(cardIDs))
+	for i, cardID := range cardIDs {
+		result[i] = string(cardID)
+	}
+	return result
+}
+
+// Histories is the history log in chronological order.
+type Histories []History
+
+// Between returns the Histories from turn from to turn to, inclusive.
+func (h Histories) Between(from, to Turn) Histories {
+	result := Histories{}
+	for _, history := range h {
+		if history.Turn >= from && history.Turn <= to {
+			result = append(result, history)
+		}
+	}
+	return result
+}
+
+// OfKind returns the Histories of any of kinds.
+func (h Histories) OfKind(kinds ...HistoryKind) Histories {
+	result := Histories{}
+	for _, history := range h {
+		if slices.Contains(kinds, history.Kind) {
+			result = append(result, history)
+		}
+	}
+	return result
+}
+
+// WriteJSON writes the Histories to w as a JSON array.
+func (h Histories) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(h)
+}
+
+// WriteCSV writes the Histories to w as CSV with a header. The data column is encoded in JSON.
+func (h Histories) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"turn", "kind", "key", "data"}); err != nil {
+		return err
+	}
+	for _, history := range h {
+		data, err := json.Marshal(history.Data)
+		if err != nil {
+			return err
+		}
+		record := []string{strconv.Itoa(int(history.Turn)), string(history.Kind), history.Key, string(data)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,83 @@
+package core_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestHistories_Query(t *testing.T) {
+	enemy := core.NewEnemy("enemy-goblin", "goblin", 3, []*core.EnemySkill{}, 3)
+	pack := &core.CardPack{CardPackID: "cardpack-free"}
+
+	histories := core.Histories{
+		core.NewTurnEndedHistory(0, core.ResourceQuantity{Money: 1}),
+		core.NewConquestHistory(1, enemy, core.NewTerrain("terrain-forest", core.ResourceQuantity{}, 3)),
+		core.NewPurchaseHistory(2, "nation-mynation", pack),
+		core.NewPackContentsHistory(2, pack, []core.CardID{"battlecard-soldier"}),
+		core.NewBattleLostHistory(3, enemy),
+	}
+
+	if got := histories.Between(1, 2); len(got) != 3 {
+		t.Errorf("len(Between(1, 2)) = %d, want 3", len(got))
+	}
+	got := histories.OfKind(core.HistoryKindConquest, core.HistoryKindBattleLost)
+	if len(got) != 2 || got[0].Turn != 1 || got[1].Turn != 3 {
+		t.Errorf("OfKind() = %v, want the conquest and the lost battle", got)
+	}
+	if got := histories.Between(3, 5).OfKind(core.HistoryKindPurchase); len(got) != 0 {
+		t.Errorf("len(Between(3, 5).OfKind(purchase)) = %d, want 0", len(got))
+	}
+}
+
+func TestHistories_Export(t *testing.T) {
+	histories := core.Histories{
+		core.NewMarketLevelHistory(4, "nation-forest", 2),
+		core.NewTurnEndedHistory(5, core.ResourceQuantity{Money: 3, Food: 1}),
+	}
+
+	var jsonBuf bytes.Buffer
+	if err := histories.WriteJSON(&jsonBuf); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(jsonBuf.Bytes(), &decoded); err != nil {
+		t.Fatalf("WriteJSON() wrote invalid JSON: %v", err)
+	}
+	if len(decoded) != 2 || decoded[0]["kind"] != "market-level" || decoded[1]["turn"] != 5.0 {
+		t.Errorf("WriteJSON() = %s", jsonBuf.String())
+	}
+
+	var csvBuf bytes.Buffer
+	if err := histories.WriteCSV(&csvBuf); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvBuf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("WriteCSV() wrote %d lines, want 3: %s", len(lines), csvBuf.String())
+	}
+	if lines[0] != "turn,kind,key,data" {
+		t.Errorf("header = %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], "4,market-level,history-market,") {
+		t.Errorf("line 1 = %q", lines[1])
+	}
+}
+
+func TestGameState_NextTurnHistory(t *testing.T) {
+	gameState := &core.GameState{
+		Treasury: &core.Treasury{},
+		MapGrid:  &core.MapGrid{Size: core.MapGridSize{X: 1, Y: 1}, Points: []core.Point{&core.MyNationPoint{}}},
+	}
+
+	gameState.NextTurn(&MockIntner{})
+	gameState.NextTurn(&MockIntner{})
+
+	turns := gameState.Histories.OfKind(core.HistoryKindTurnEnded)
+	if len(turns) != 2 || turns[0].Turn != 0 || turns[1].Turn != 1 {
+		t.Errorf("turn ended histories = %v, want turns 0 and 1", turns)
+	}
+}
@@ -45,6 +45,17 @@ type MarketPoint interface {
 // MyNationPoint is a point of the player's nation.
 type MyNationPoint struct {
 	MyNation *MyNation
+	fallen   bool // Whether the capital has fallen to the enemy
+}
+
+// Fall makes the capital fall to the enemy.
+func (p *MyNationPoint) Fall() {
+	p.fallen = true
+}
+
+// Fallen returns true if the capital has fallen.
+func (p *MyNationPoint) Fallen() bool {
+	return p.fallen
 }
 
 func (p *MyNationPoint) PointType() PointType {
@@ -160,7 +171,7 @@ func (p *WildernessPoint) Terrain() *Terrain {
 
 func (p *WildernessPoint) CardSlot() int {
 	if p.territory != nil {
-		return p.territory.Terrain().CardSlot()
+		return p.territory.CardSlot()
 	}
 	return 0
 }
@@ -198,11 +209,24 @@ func (p *WildernessPoint) Territory() *Territory {
 }
 
 // BossPoint is a point of a boss.
+// A boss is fought in ordered phases, and the point is defeated when the last phase is beaten.
 type BossPoint struct {
-	boss     *Enemy
+	phases   []*BossPhase
+	phase    int  // Index of the current phase
 	defeated bool // Whether the boss has been defeated
 }
 
+// NewBossPoint creates a new BossPoint which is fought in the given phases in order.
+func NewBossPoint(phases []*BossPhase) *BossPoint {
+	return &BossPoint{phases: phases}
+}
+
+// BossPhase is a phase of a boss encounter.
+type BossPhase struct {
+	Enemy       *Enemy // Enemy is the opponent in this phase.
+	DialogueKey string // DialogueKey is the lang key of the dialogue shown in this phase.
+}
+
 func (p *BossPoint) PointType() PointType {
 	return PointTypeBoss
 }
@@ -212,7 +236,7 @@ func (p *BossPoint) Passable() bool {
 }
 
 func (p *BossPoint) AsBattlePoint() (BattlePoint, bool) {
-	if !p.defeated && p.boss != nil {
+	if !p.defeated && p.Enemy() != nil {
 		return p, true
 	}
 	return nil, false
@@ -228,16 +252,59 @@ func (p *BossPoint) AsMarketPoint() (MarketPoint, bool) {
 
 // BattlePoint interface implementation
 func (p *BossPoint) Enemy() *Enemy {
-	return p.boss
+	current := p.CurrentPhase()
+	if current == nil {
+		return nil
+	}
+	return current.Enemy
 }
 
+// Conquer beats the current phase. The boss is defeated when the final phase is beaten.
 func (p *BossPoint) Conquer() {
-	p.defeated = true
+	if p.IsFinalPhase() {
+		p.defeated = true
+		return
+	}
+	p.phase++
 }
 
-// SetBossForTest sets the boss for testing purposes.
+// Recover brings the boss back to its first phase. It is called when the player retreats from or loses the battle.
+func (p *BossPoint) Recover() {
+	p.phase = 0
+}
+
+// CurrentPhase returns the current phase, or nil if the boss has no phases.
+func (p *BossPoint) CurrentPhase() *BossPhase {
+	if p.phase < 0 || p.phase >= len(p.phases) {
+		return nil
+	}
+	return p.phases[p.phase]
+}
+
+// Phase returns the index of the current phase, starting from 0.
+func (p *BossPoint) Phase() int {
+	return p.phase
+}
+
+// Phases returns all the phases in order.
+func (p *BossPoint) Phases() []*BossPhase {
+	return p.phases
+}
+
+// NumPhases returns the number of phases.
+func (p *BossPoint) NumPhases() int {
+	return len(p.phases)
+}
+
+// IsFinalPhase returns true if the current phase is the last one.
+func (p *BossPoint) IsFinalPhase() bool {
+	return p.phase >= len(p.phases)-1
+}
+
+// SetBossForTest sets the boss for testing purposes. The boss has a single phase.
 func (p *BossPoint) SetBossForTest(boss *Enemy) {
-	p.boss = boss
+	p.phases = []*BossPhase{{Enemy: boss}}
+	p.phase = 0
 }
 
 // SetDefeatedForTest sets the defeated status for testing purposes.
@@ -245,16 +312,27 @@ func (p *BossPoint) SetDefeatedForTest(defeated bool) {
 	p.defeated = defeated
 }
 
-// Boss returns the boss enemy.
+// Boss returns the boss enemy of the current phase.
 func (p *BossPoint) Boss() *Enemy {
-	return p.boss
+	return p.Enemy()
 }
 
 // MapGrid is the game's map grid.
 type MapGrid struct {
-	Size       MapGridSize
-	Points     []Point // List of Points. The index is calculated by y*SizeX + x.
-	accesibles []bool
+	Size         MapGridSize
+	Points       []Point       // List of Points. The index is calculated by y*SizeX + x.
+	SynergyRules []SynergyRule // SynergyRules give extra yield to Territories based on their neighbours.
+	accesibles   []bool
+	revealed     []bool
+}
+
+// FallCapital makes all MyNationPoints fall to the enemy.
+func (m *MapGrid) FallCapital() {
+	for _, point := range m.Points {
+		if myNationPoint, ok := point.(*MyNationPoint); ok {
+			myNationPoint.Fall()
+		}
+	}
 }
 
 // GetPoint gets the Point at the specified coordinates.
@@ -354,6 +432,31 @@ func (m *MapGrid) CanInteract(x, y int) bool {
 	return m.accesibles[idx]
 }
 
+// Reveal makes the details of the enemy at the specified coordinates known even if the Point is not accessible.
+func (m *MapGrid) Reveal(x, y int) {
+	idx, ok := m.IndexFromXY(x, y)
+	if !ok {
+		return
+	}
+	if m.revealed == nil {
+		m.revealed = make([]bool, len(m.Points))
+	}
+	m.revealed[idx] = true
+}
+
+// IsRevealed determines whether the details of the enemy at the specified coordinates are known.
+// Accessible Points are always revealed.
+func (m *MapGrid) IsRevealed(x, y int) bool {
+	if m.CanInteract(x, y) {
+		return true
+	}
+	idx, ok := m.IndexFromXY(x, y)
+	if !ok || m.revealed == nil {
+		return false
+	}
+	return m.revealed[idx]
+}
+
 func (m *MapGrid) CreateBattlefield(x, y int) (*Battlefield, bool) {
 	point, ok := m.GetPoint(x, y)
 	if !ok {
@@ -365,42 +468,28 @@ func (m *MapGrid) CreateBattlefield(x, y int) (*Battlefield, bool) {
 		return nil, false
 	}
 
-	around := [8]int{
-		-1, 0,
-		0, -1,
-		1, 0,
-		0, 1,
-	}
-
-	cardSlot := battlePoint.Enemy().battleCardSlot
+	contributions := m.SupportContributions(x, y)
+	supportCardSlot := 0
 	supportPower := 0.0
-	for i := range 4 {
-		ax := x + around[2*1]
-		ay := y + around[2*i+1]
-
-		p, ok := m.GetPoint(ax, ay)
-		if !ok {
-			continue
-		}
-		tp, ok := p.AsTerritoryPoint()
-		if !ok {
-			continue
-		}
-
-		// FIXME: accessing private fields
-		for _, card := range tp.Cards() {
-			cardSlot += card.supportCardSlot
-			supportPower += card.supportPower
-		}
+	for _, contribution := range contributions {
+		supportCardSlot += contribution.CardSlot
+		supportPower += contribution.Power
 	}
 
-	// TODO: use constructor
-	return &Battlefield{
-		Point:            battlePoint,
-		Enemy:            battlePoint.Enemy(),
-		BaseSupportPower: supportPower,
-		CardSlot:         cardSlot,
-	}, true
+	// Bosses are fought over several rounds.
+	var battlefield *Battlefield
+	if point.PointType() == PointTypeBoss {
+		battlefield = NewMultiRoundBattlefield(battlePoint.Enemy(), supportPower, MultiRoundBattleMaxRounds)
+	} else {
+		battlefield = NewBattlefield(battlePoint.Enemy(), supportPower)
+	}
+	battlefield.Point = battlePoint
+	battlefield.SupportContributions = contributions
+	battlefield.CardSlot += supportCardSlot
+	if wilderness, ok := point.(*WildernessPoint); ok {
+		battlefield.ApplyTerrain(wilderness.Terrain())
+	}
+	return battlefield, true
 }
 
 func (m *MapGrid) CreateConstructionPlan(x, y int) (*ConstructionPlan, bool) {
@@ -235,6 +235,36 @@ func TestBossPoint_Basic(t *testing.T) {
 	}
 }
 
+func TestBossPoint_Phases(t *testing.T) {
+	phase1 := core.NewEnemy("final_boss", "ancient_dragon", 100.0, []*core.EnemySkill{}, 5)
+	phase2 := core.NewEnemy("final_boss", "ancient_dragon", 150.0, []*core.EnemySkill{}, 6)
+
+	point := core.NewBossPoint([]*core.BossPhase{
+		{Enemy: phase1, DialogueKey: "phase-1"},
+		{Enemy: phase2, DialogueKey: "phase-2"},
+	})
+
+	if point.NumPhases() != 2 {
+		t.Errorf("NumPhases() = %v, want %v", point.NumPhases(), 2)
+	}
+	if point.Enemy() != phase1 || point.IsFinalPhase() {
+		t.Errorf("first phase: Enemy() = %v, IsFinalPhase() = %v", point.Enemy(), point.IsFinalPhase())
+	}
+
+	point.Conquer()
+	if point.Phase() != 1 || point.Enemy() != phase2 || !point.IsFinalPhase() {
+		t.Errorf("second phase: Phase() = %v, Enemy() = %v, IsFinalPhase() = %v", point.Phase(), point.Enemy(), point.IsFinalPhase())
+	}
+	if _, ok := point.AsBattlePoint(); !ok {
+		t.Errorf("AsBattlePoint() should succeed before the final phase is beaten")
+	}
+
+	point.Conquer()
+	if _, ok := point.AsBattlePoint(); ok {
+		t.Errorf("AsBattlePoint() should fail after the final phase is beaten")
+	}
+}
+
 func TestMapGrid_GetPoint(t *testing.T) {
 	// Points for testing
 	myNation := core.NewMyNation("player", "Player Nation")
@@ -141,3 +141,8 @@ func (t *Treasury) Sub(other ResourceQuantity) bool {
 	t.Resources = t.Resources.Sub(other)
 	return true
 }
+
+// Pay subtracts the given other from the treasury even if it is insufficient. The treasury can fall into debt.
+func (t *Treasury) Pay(other ResourceQuantity) {
+	t.Resources = t.Resources.Sub(other)
+}
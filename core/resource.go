@@ -1,5 +1,7 @@
 package core
 
+import "math"
+
 // ResourceQuantity represents the amount of 5 types of Resources.
 
 // ResourceQuantity is a struct that represents the amount of 5 types of resources.
@@ -42,6 +44,20 @@ func (rq ResourceQuantity) CanPurchase(price ResourceQuantity) bool {
 		rq.Mana >= price.Mana
 }
 
+// Scale multiplies each resource by factor, rounding to the nearest integer.
+func (rq ResourceQuantity) Scale(factor float64) ResourceQuantity {
+	scale := func(v int) int {
+		return int(math.Round(float64(v) * factor))
+	}
+	return ResourceQuantity{
+		Money: scale(rq.Money),
+		Food:  scale(rq.Food),
+		Wood:  scale(rq.Wood),
+		Iron:  scale(rq.Iron),
+		Mana:  scale(rq.Mana),
+	}
+}
+
 type ResourceModifier struct {
 	Money float64
 	Food  float64
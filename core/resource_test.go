@@ -202,3 +202,39 @@ func TestResourceQuantity_CanPurchase(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceQuantity_Scale(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity core.ResourceQuantity
+		factor   float64
+		expected core.ResourceQuantity
+	}{
+		{
+			name:     "Double",
+			quantity: core.ResourceQuantity{Money: 10, Food: 5, Wood: 3, Iron: 2, Mana: 1},
+			factor:   2.0,
+			expected: core.ResourceQuantity{Money: 20, Food: 10, Wood: 6, Iron: 4, Mana: 2},
+		},
+		{
+			name:     "Rounded to the nearest integer",
+			quantity: core.ResourceQuantity{Money: 10, Food: 5, Wood: 3, Iron: 2, Mana: 1},
+			factor:   1.25,
+			expected: core.ResourceQuantity{Money: 13, Food: 6, Wood: 4, Iron: 3, Mana: 1},
+		},
+		{
+			name:     "Zero",
+			quantity: core.ResourceQuantity{Money: 10, Food: 5},
+			factor:   0.0,
+			expected: core.ResourceQuantity{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quantity.Scale(tt.factor); got != tt.expected {
+				t.Errorf("Scale() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package core
+
+// GameStats is the statistics of a run.
+type GameStats struct {
+	PacksOpened int // PacksOpened is the number of CardPacks opened.
+	BattlesWon  int // BattlesWon is the number of battles won.
+	BattlesLost int // BattlesLost is the number of battles lost.
+
+	BossBattlesLost int // BossBattlesLost is the number of battles lost against a boss.
+}
+
+// Score weights used by GameState.Score.
+const (
+	ScorePerTerritory = 100  // Score for each conquered Territory.
+	ScorePerPack      = 10   // Score for each opened CardPack.
+	ScorePerBattleWon = 50   // Score for each battle won.
+	ScorePerResource  = 1    // Score for each unit of Resource left in the Treasury.
+	ScorePerTurn      = -5   // Score for each turn spent.
+	ScoreVictoryBonus = 1000 // Score for defeating all bosses.
+)
+
+// ConqueredTerritories returns the number of controlled WildernessPoints.
+func (g *GameState) ConqueredTerritories() int {
+	count := 0
+	for _, point := range g.MapGrid.Points {
+		if wilderness, ok := point.(*WildernessPoint); ok && wilderness.controlled {
+			count++
+		}
+	}
+	return count
+}
+
+// TerritoryLevels returns the development level of every held Territory.
+func (g *GameState) TerritoryLevels() map[TerritoryID]int {
+	levels := make(map[TerritoryID]int)
+	for _, point := range g.MapGrid.Points {
+		if point == nil {
+			continue
+		}
+		if territoryPoint, ok := point.AsTerritoryPoint(); ok {
+			territory := territoryPoint.Territory()
+			levels[territory.ID()] = territory.Level()
+		}
+	}
+	return levels
+}
+
+// Score returns the score of the run, multiplied by the ScoreMultiplier of the Difficulty. It never goes below 0.
+func (g *GameState) Score() int {
+	score := g.ConqueredTerritories() * ScorePerTerritory
+	score += g.Stats.PacksOpened * ScorePerPack
+	score += g.Stats.BattlesWon * ScorePerBattleWon
+	score += int(g.CurrentTurn) * ScorePerTurn
+
+	if g.Treasury != nil {
+		r := g.Treasury.Resources
+		score += (r.Money + r.Food + r.Wood + r.Iron + r.Mana) * ScorePerResource
+	}
+
+	if g.IsVictory() {
+		score += ScoreVictoryBonus
+	}
+
+	if score < 0 {
+		return 0
+	}
+	if g.Difficulty != nil {
+		score = int(float64(score) * g.Difficulty.ScoreMultiplier)
+	}
+	return score
+}
@@ -0,0 +1,100 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestGameState_Score(t *testing.T) {
+	conquered := &core.WildernessPoint{}
+	conquered.SetControlledForTest(true)
+	unconquered := &core.WildernessPoint{}
+	unconquered.SetControlledForTest(false)
+
+	bossPoint := &core.BossPoint{}
+	bossPoint.SetBossForTest(core.NewEnemy("final_boss", "dragon", 100.0, []*core.EnemySkill{}, 4))
+
+	tests := []struct {
+		name       string
+		defeated   bool
+		turn       core.Turn
+		difficulty *core.Difficulty
+		expected   int
+	}{
+		{
+			name:     "Without victory",
+			turn:     10,
+			expected: 100 + 3*10 + 2*50 + 15 - 10*5,
+		},
+		{
+			name:     "With victory",
+			defeated: true,
+			turn:     10,
+			expected: 100 + 3*10 + 2*50 + 15 - 10*5 + 1000,
+		},
+		{
+			name:       "With difficulty multiplier",
+			defeated:   true,
+			turn:       10,
+			difficulty: &core.Difficulty{ID: "hard", ScoreMultiplier: 1.5},
+			expected:   (100 + 3*10 + 2*50 + 15 - 10*5 + 1000) * 3 / 2,
+		},
+		{
+			name:     "Never below zero",
+			turn:     1000,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bossPoint.SetDefeatedForTest(tt.defeated)
+
+			gameState := &core.GameState{
+				MapGrid: &core.MapGrid{
+					Size:   core.MapGridSize{X: 3, Y: 1},
+					Points: []core.Point{conquered, unconquered, bossPoint},
+				},
+				Treasury:    &core.Treasury{Resources: core.ResourceQuantity{Money: 10, Food: 5}},
+				CurrentTurn: tt.turn,
+				Stats:       core.GameStats{PacksOpened: 3, BattlesWon: 2},
+				Difficulty:  tt.difficulty,
+			}
+
+			if got := gameState.ConqueredTerritories(); got != 1 {
+				t.Errorf("ConqueredTerritories() = %v, want %v", got, 1)
+			}
+			if got := gameState.Score(); got != tt.expected {
+				t.Errorf("Score() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGameState_TerritoryLevels(t *testing.T) {
+	development := &core.TerritoryDevelopment{
+		Levels: []*core.TerritoryLevel{{Cost: core.ResourceQuantity{Money: 10}}},
+	}
+	developed := core.NewTerritoryWithDevelopment("developed", core.NewTerrain("plain", core.ResourceQuantity{}, 2), development)
+	developed.LevelUp(&core.Treasury{Resources: core.ResourceQuantity{Money: 10}})
+
+	held := &core.WildernessPoint{}
+	held.SetControlledForTest(true)
+	held.SetTerritoryForTest(developed)
+	notHeld := &core.WildernessPoint{}
+	notHeld.SetControlledForTest(false)
+	notHeld.SetTerritoryForTest(core.NewTerritory("not-held", core.NewTerrain("plain", core.ResourceQuantity{}, 2)))
+
+	gameState := &core.GameState{
+		MapGrid: &core.MapGrid{
+			Size:   core.MapGridSize{X: 2, Y: 1},
+			Points: []core.Point{held, notHeld},
+		},
+	}
+
+	levels := gameState.TerritoryLevels()
+	if len(levels) != 1 || levels["developed"] != 2 {
+		t.Errorf("TerritoryLevels() = %v, want map[developed:2]", levels)
+	}
+}
@@ -0,0 +1,197 @@
+package core
+
+import "math"
+
+// StructureEffect is an effect of a StructureCard.
+// Passive effects change the yield of the Territory and the support of adjacent battles.
+// Triggered effects run on turn start, on battle creation, on battle resolution and on conquest of an adjacent point.
+type StructureEffect interface {
+	// AddYield adds to the yield of the Territory. It is applied before ModifyYield of all effects.
+	AddYield(yield ResourceQuantity) ResourceQuantity
+	// ModifyYield modifies the yield of the Territory after all additions.
+	ModifyYield(yield ResourceQuantity) ResourceQuantity
+	// SupportPowerAt returns the support power provided to a battle at the given graph distance from the Territory.
+	// Adjacent battles are at distance 1.
+	SupportPowerAt(distance int) float64
+	// SupportCardSlot returns the additional card slots provided to adjacent battles.
+	SupportCardSlot() int
+	// OnTurnStart is called for every controlled Territory when a new turn starts.
+	OnTurnStart(ctx *StructureEffectContext)
+	// OnBattlefieldCreated is called when a battle starts next to the Territory.
+	OnBattlefieldCreated(ctx *StructureEffectContext, battlefield *Battlefield)
+	// OnBattleResolved is called when a battle next to the Territory is resolved, before the survivors return to the CardDeck.
+	OnBattleResolved(ctx *StructureEffectContext, result *BattleResult)
+	// OnAdjacentConquered is called when the point at (x, y) next to the Territory is conquered.
+	OnAdjacentConquered(ctx *StructureEffectContext, x, y int)
+}
+
+// StructureEffectContext is the context passed to the triggers of StructureEffects.
+type StructureEffectContext struct {
+	GameState *GameState
+	X, Y      int    // Coordinates of the Territory of the StructureCard
+	Intner    Intner // Random number generator
+}
+
+// StructureEffectBase is an empty StructureEffect. Embed it to implement only some of the methods.
+type StructureEffectBase struct{}
+
+func (StructureEffectBase) AddYield(yield ResourceQuantity) ResourceQuantity {
+	return yield
+}
+
+func (StructureEffectBase) ModifyYield(yield ResourceQuantity) ResourceQuantity {
+	return yield
+}
+
+func (StructureEffectBase) SupportPowerAt(distance int) float64 {
+	return 0
+}
+
+func (StructureEffectBase) SupportCardSlot() int {
+	return 0
+}
+
+func (StructureEffectBase) OnTurnStart(ctx *StructureEffectContext) {}
+
+func (StructureEffectBase) OnBattlefieldCreated(ctx *StructureEffectContext, battlefield *Battlefield) {
+}
+
+func (StructureEffectBase) OnBattleResolved(ctx *StructureEffectContext, result *BattleResult) {}
+
+func (StructureEffectBase) OnAdjacentConquered(ctx *StructureEffectContext, x, y int) {}
+
+// StructureEffectYieldAdditive adds Yield to the yield of the Territory.
+type StructureEffectYieldAdditive struct {
+	StructureEffectBase
+	Yield ResourceQuantity
+}
+
+func (e *StructureEffectYieldAdditive) AddYield(yield ResourceQuantity) ResourceQuantity {
+	return yield.Add(e.Yield)
+}
+
+// StructureEffectYieldModifier multiplies the yield of the Territory by Modifier.
+type StructureEffectYieldModifier struct {
+	StructureEffectBase
+	Modifier ResourceModifier
+}
+
+func (e *StructureEffectYieldModifier) ModifyYield(yield ResourceQuantity) ResourceQuantity {
+	return e.Modifier.Modify(yield)
+}
+
+// StructureEffectSupport supports the battles around the Territory.
+// The power reaches battles within Range and is multiplied by Falloff for each step beyond the first.
+// The card slots are given only to adjacent battles.
+type StructureEffectSupport struct {
+	StructureEffectBase
+	Power    float64
+	CardSlot int
+	Range    int     // Range is the max graph distance the power reaches. Values below 1 mean adjacent battles only.
+	Falloff  float64 // Falloff is the ratio of the power kept for each step beyond the first.
+}
+
+func (e *StructureEffectSupport) SupportPowerAt(distance int) float64 {
+	if distance < 1 || distance > max(e.Range, 1) {
+		return 0
+	}
+	return e.Power * math.Pow(e.Falloff, float64(distance-1))
+}
+
+func (e *StructureEffectSupport) SupportCardSlot() int {
+	return e.CardSlot
+}
+
+// StructureEffectReveal reveals the enemies within Range (Manhattan distance) of the Territory on turn start,
+// and the enemies within Range of a conquered adjacent point.
+type StructureEffectReveal struct {
+	StructureEffectBase
+	Range int
+}
+
+func (e *StructureEffectReveal) OnTurnStart(ctx *StructureEffectContext) {
+	e.reveal(ctx.GameState.MapGrid, ctx.X, ctx.Y)
+}
+
+func (e *StructureEffectReveal) OnAdjacentConquered(ctx *StructureEffectContext, x, y int) {
+	e.reveal(ctx.GameState.MapGrid, x, y)
+}
+
+func (e *StructureEffectReveal) reveal(mapGrid *MapGrid, x, y int) {
+	for dy := -e.Range; dy <= e.Range; dy++ {
+		for dx := -e.Range; dx <= e.Range; dx++ {
+			if abs(dx)+abs(dy) > e.Range {
+				continue
+			}
+			mapGrid.Reveal(x+dx, y+dy)
+		}
+	}
+}
+
+// StructureEffectHeal returns up to Count lost BattleCards to the survivors after each adjacent battle.
+type StructureEffectHeal struct {
+	StructureEffectBase
+	Count int
+}
+
+func (e *StructureEffectHeal) OnBattleResolved(ctx *StructureEffectContext, result *BattleResult) {
+	for range e.Count {
+		if len(result.Losses) == 0 {
+			return
+		}
+		card := result.Losses[0]
+		result.Losses = result.Losses[1:]
+		result.Survivors = append(result.Survivors, card)
+	}
+}
+
+// StructureEffectGrantCard adds one of CardIDs at random to the CardDeck every Interval turns.
+type StructureEffectGrantCard struct {
+	StructureEffectBase
+	Interval Turn
+	CardIDs  []CardID
+}
+
+func (e *StructureEffectGrantCard) OnTurnStart(ctx *StructureEffectContext) {
+	if e.Interval <= 0 || len(e.CardIDs) == 0 {
+		return
+	}
+	if ctx.GameState.CurrentTurn%e.Interval != 0 {
+		return
+	}
+	cardID := e.CardIDs[ctx.Intner.Intn(len(e.CardIDs))]
+	ctx.GameState.CardDeck.Add(cardID)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// triggerStructureEffects calls f for every effect of the StructureCards in the controlled Territory at (x, y).
+func (g *GameState) triggerStructureEffects(x, y int, intner Intner, f func(ctx *StructureEffectContext, effect StructureEffect)) {
+	point, ok := g.MapGrid.GetPoint(x, y)
+	if !ok || point == nil {
+		return
+	}
+	territoryPoint, ok := point.AsTerritoryPoint()
+	if !ok {
+		return
+	}
+
+	ctx := &StructureEffectContext{GameState: g, X: x, Y: y, Intner: intner}
+	for _, card := range territoryPoint.Cards() {
+		for _, effect := range card.Effects() {
+			f(ctx, effect)
+		}
+	}
+}
+
+// triggerAdjacentStructureEffects calls f for every effect of the StructureCards in the Territories adjacent to (x, y).
+func (g *GameState) triggerAdjacentStructureEffects(x, y int, intner Intner, f func(ctx *StructureEffectContext, effect StructureEffect)) {
+	for _, d := range neighbourOffsets {
+		g.triggerStructureEffects(x+d[0], y+d[1], intner, f)
+	}
+}
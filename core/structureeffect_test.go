@@ -0,0 +1,158 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+type conquestRecorder struct {
+	core.StructureEffectBase
+	conquered [][2]int
+}
+
+func (r *conquestRecorder) OnAdjacentConquered(ctx *core.StructureEffectContext, x, y int) {
+	r.conquered = append(r.conquered, [2]int{x, y})
+}
+
+func newStructureEffectTestGameState(cards ...*core.StructureCard) (*core.GameState, *core.WildernessPoint) {
+	territory := core.NewTerritory("territory", core.NewTerrain("terrain", core.ResourceQuantity{}, 3))
+	plan := core.NewConstructionPlan(territory)
+	for _, card := range cards {
+		plan.AddCard(card)
+	}
+	territory.ApplyConstructionPlan(plan)
+
+	controlled := &core.WildernessPoint{}
+	controlled.SetControlledForTest(true)
+	controlled.SetTerritoryForTest(territory)
+
+	enemyTerritory := core.NewTerritory("enemy_territory", core.NewTerrain("terrain", core.ResourceQuantity{}, 3))
+	battlePoint := &core.WildernessPoint{}
+	battlePoint.SetTerritoryForTest(enemyTerritory)
+	battlePoint.SetEnemyForTest(core.NewEnemy("enemy", "goblin", 20.0, []*core.EnemySkill{}, 3))
+
+	// Layout (5x1): MyNation | controlled | battle point | wilderness | wilderness
+	gameState := &core.GameState{
+		CardDeck:    core.NewCardDeck(),
+		Treasury:    &core.Treasury{},
+		CurrentTurn: 4,
+		MapGrid: &core.MapGrid{
+			Size: core.MapGridSize{X: 5, Y: 1},
+			Points: []core.Point{
+				&core.MyNationPoint{MyNation: core.NewMyNation("player", "My Nation")},
+				controlled,
+				battlePoint,
+				&core.WildernessPoint{},
+				&core.WildernessPoint{},
+			},
+		},
+	}
+	return gameState, battlePoint
+}
+
+func TestStructureCard_Effects(t *testing.T) {
+	card := core.NewStructureCardWithEffects("card",
+		&core.StructureEffectYieldAdditive{Yield: core.ResourceQuantity{Wood: 2}},
+		&core.StructureEffectYieldModifier{Modifier: core.ResourceModifier{Wood: 0.5}},
+		&core.StructureEffectSupport{Power: 3, CardSlot: 1},
+	)
+
+	territory := core.NewTerritory("territory", core.NewTerrain("terrain", core.ResourceQuantity{Wood: 2}, 1))
+	plan := core.NewConstructionPlan(territory)
+	plan.AddCard(card)
+	territory.ApplyConstructionPlan(plan)
+
+	if got := territory.Yield(); got != (core.ResourceQuantity{Wood: 6}) {
+		t.Errorf("Yield() = %v, want Wood 6", got)
+	}
+	if got := card.SupportPower(); got != 3 {
+		t.Errorf("SupportPower() = %v, want 3", got)
+	}
+	if got := card.SupportCardSlot(); got != 1 {
+		t.Errorf("SupportCardSlot() = %v, want 1", got)
+	}
+}
+
+func TestGameState_StructureEffectTriggers(t *testing.T) {
+	t.Run("Hospital returns a lost card", func(t *testing.T) {
+		hospital := core.NewStructureCardWithEffects("hospital", &core.StructureEffectHeal{Count: 1})
+		gameState, _ := newStructureEffectTestGameState(hospital)
+
+		if !gameState.InitBattlefield(2, 0, &MockIntner{}) {
+			t.Fatalf("InitBattlefield() failed")
+		}
+		battlefield, _ := gameState.Battlefield()
+		battlefield.AddBattleCard(core.NewBattleCard("warrior", 5.0, nil, "warrior"))
+		battlefield.AddBattleCard(core.NewBattleCard("archer", 5.0, nil, "archer"))
+
+		result, _ := gameState.ResolveBattle(&MockIntner{values: []int{99, 99}})
+		if len(result.Losses) != 1 || len(result.Survivors) != 1 {
+			t.Errorf("Survivors/Losses = %d/%d, want 1/1", len(result.Survivors), len(result.Losses))
+		}
+		if gameState.CardDeck.Count("warrior") != 1 {
+			t.Errorf("the returned card should be added to the deck")
+		}
+	})
+
+	t.Run("Conquest of an adjacent point", func(t *testing.T) {
+		recorder := &conquestRecorder{}
+		gameState, battlePoint := newStructureEffectTestGameState(core.NewStructureCardWithEffects("recorder", recorder))
+
+		gameState.InitBattlefield(2, 0, &MockIntner{})
+		battlefield, _ := gameState.Battlefield()
+		battlefield.AddBattleCard(core.NewBattleCard("warrior", 30.0, nil, "warrior"))
+		gameState.ResolveBattle(&MockIntner{})
+
+		if _, ok := battlePoint.AsTerritoryPoint(); !ok {
+			t.Fatalf("the battle point should be conquered")
+		}
+		if len(recorder.conquered) != 1 || recorder.conquered[0] != [2]int{2, 0} {
+			t.Errorf("conquered = %v, want [[2 0]]", recorder.conquered)
+		}
+	})
+
+	t.Run("Shrine grants a card on the interval", func(t *testing.T) {
+		shrine := core.NewStructureCardWithEffects("shrine", &core.StructureEffectGrantCard{Interval: 5, CardIDs: []core.CardID{"mage"}})
+		gameState, _ := newStructureEffectTestGameState(shrine)
+
+		gameState.NextTurn(&MockIntner{}) // Turn 5
+		if gameState.CardDeck.Count("mage") != 1 {
+			t.Errorf("Count(mage) = %d on turn 5, want 1", gameState.CardDeck.Count("mage"))
+		}
+		gameState.NextTurn(&MockIntner{}) // Turn 6
+		if gameState.CardDeck.Count("mage") != 1 {
+			t.Errorf("Count(mage) = %d on turn 6, want 1", gameState.CardDeck.Count("mage"))
+		}
+	})
+
+	t.Run("Watchtower reveals enemies on turn start", func(t *testing.T) {
+		watchtower := core.NewStructureCardWithEffects("watchtower", &core.StructureEffectReveal{Range: 2})
+		gameState, _ := newStructureEffectTestGameState(watchtower)
+
+		if gameState.MapGrid.IsRevealed(3, 0) {
+			t.Errorf("IsRevealed(3, 0) = true before the turn start, want false")
+		}
+		gameState.NextTurn(&MockIntner{})
+		if !gameState.MapGrid.IsRevealed(3, 0) {
+			t.Errorf("IsRevealed(3, 0) = false, want true")
+		}
+		if gameState.MapGrid.IsRevealed(4, 0) {
+			t.Errorf("IsRevealed(4, 0) = true out of range, want false")
+		}
+	})
+
+	t.Run("Watchtower reveals enemies around a conquered point", func(t *testing.T) {
+		watchtower := core.NewStructureCardWithEffects("watchtower", &core.StructureEffectReveal{Range: 2})
+		gameState, _ := newStructureEffectTestGameState(watchtower)
+
+		gameState.InitBattlefield(2, 0, &MockIntner{})
+		battlefield, _ := gameState.Battlefield()
+		battlefield.AddBattleCard(core.NewBattleCard("warrior", 30.0, nil, "warrior"))
+		gameState.ResolveBattle(&MockIntner{})
+
+		if !gameState.MapGrid.IsRevealed(4, 0) {
+			t.Errorf("IsRevealed(4, 0) = false, want true")
+		}
+	})
+}
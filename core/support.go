@@ -0,0 +1,65 @@
+package core
+
+// SupportContribution is the support a Territory gives to a battle.
+type SupportContribution struct {
+	X, Y      int        // Coordinates of the Territory
+	Territory *Territory // Territory is the supporting Territory.
+	Distance  int        // Distance is the graph distance from the battle along controlled paths.
+	Power     float64    // Power is the support power after the falloff.
+	CardSlot  int        // CardSlot is the number of additional card slots.
+}
+
+// SupportContributions returns the support given by the Territories to the battle at (x, y).
+// The distance is measured along passable Points, so the support does not go through enemies.
+// Territories giving no support are omitted. The result is ordered by distance.
+func (m *MapGrid) SupportContributions(x, y int) []*SupportContribution {
+	start, ok := m.IndexFromXY(x, y)
+	if !ok {
+		return nil
+	}
+
+	distances := map[int]int{start: 0}
+	queue := []int{start}
+	var contributions []*SupportContribution
+
+	for len(queue) > 0 {
+		idx := queue[0]
+		queue = queue[1:]
+		cx, cy, _ := m.XYFromIndex(idx)
+
+		for _, d := range neighbourOffsets {
+			nx, ny := cx+d[0], cy+d[1]
+			nidx, ok := m.IndexFromXY(nx, ny)
+			if !ok {
+				continue
+			}
+			if _, visited := distances[nidx]; visited {
+				continue
+			}
+			point := m.Points[nidx]
+			if point == nil || !point.Passable() {
+				continue
+			}
+			distance := distances[idx] + 1
+			distances[nidx] = distance
+			queue = append(queue, nidx)
+
+			territoryPoint, ok := point.AsTerritoryPoint()
+			if !ok {
+				continue
+			}
+			contribution := &SupportContribution{X: nx, Y: ny, Territory: territoryPoint.Territory(), Distance: distance}
+			for _, card := range territoryPoint.Cards() {
+				contribution.Power += card.SupportPowerAt(distance)
+				if distance == 1 {
+					contribution.CardSlot += card.SupportCardSlot()
+				}
+			}
+			if contribution.Power > 0 || contribution.CardSlot > 0 {
+				contributions = append(contributions, contribution)
+			}
+		}
+	}
+
+	return contributions
+}
@@ -0,0 +1,65 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestMapGrid_SupportContributions(t *testing.T) {
+	catapult := core.NewStructureCardWithEffects("catapult", &core.StructureEffectSupport{Power: 3, Range: 1})
+	camp := core.NewStructureCardWithEffects("camp", &core.StructureEffectSupport{CardSlot: 1})
+	ballista := core.NewStructureCardWithEffects("ballista", &core.StructureEffectSupport{Power: 5, Range: 2, Falloff: 0.5})
+
+	newTerritoryPoint := func(cards ...*core.StructureCard) *core.WildernessPoint {
+		territory := core.NewTerritory("territory", core.NewTerrain("terrain", core.ResourceQuantity{}, 3))
+		plan := core.NewConstructionPlan(territory)
+		for _, card := range cards {
+			plan.AddCard(card)
+		}
+		territory.ApplyConstructionPlan(plan)
+
+		point := &core.WildernessPoint{}
+		point.SetControlledForTest(true)
+		point.SetTerritoryForTest(territory)
+		return point
+	}
+
+	battlePoint := &core.WildernessPoint{}
+	battlePoint.SetEnemyForTest(core.NewEnemy("enemy", "goblin", 10, []*core.EnemySkill{}, 3))
+	farBattlePoint := &core.WildernessPoint{}
+	farBattlePoint.SetEnemyForTest(core.NewEnemy("enemy", "goblin", 10, []*core.EnemySkill{}, 3))
+
+	// Layout (6x1): MyNation | ballista | catapult+camp | battle | another enemy | ballista
+	// The territory on the left of the battle is adjacent, and the enemy on the right blocks the path.
+	mapGrid := &core.MapGrid{
+		Size: core.MapGridSize{X: 6, Y: 1},
+		Points: []core.Point{
+			&core.MyNationPoint{MyNation: core.NewMyNation("player", "My Nation")},
+			newTerritoryPoint(ballista),
+			newTerritoryPoint(catapult, camp),
+			battlePoint,
+			farBattlePoint,
+			newTerritoryPoint(ballista),
+		},
+	}
+
+	contributions := mapGrid.SupportContributions(3, 0)
+	if len(contributions) != 2 {
+		t.Fatalf("len(SupportContributions()) = %d, want 2", len(contributions))
+	}
+	if c := contributions[0]; c.X != 2 || c.Distance != 1 || c.Power != 3 || c.CardSlot != 1 {
+		t.Errorf("contributions[0] = %+v, want the adjacent catapult and camp", c)
+	}
+	if c := contributions[1]; c.X != 1 || c.Distance != 2 || c.Power != 2.5 || c.CardSlot != 0 {
+		t.Errorf("contributions[1] = %+v, want the ballista at half power", c)
+	}
+
+	battlefield, ok := mapGrid.CreateBattlefield(3, 0)
+	if !ok {
+		t.Fatalf("CreateBattlefield() failed")
+	}
+	if battlefield.BaseSupportPower != 5.5 || battlefield.CardSlot != 4 {
+		t.Errorf("BaseSupportPower/CardSlot = %v/%d, want 5.5/4", battlefield.BaseSupportPower, battlefield.CardSlot)
+	}
+}
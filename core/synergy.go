@@ -0,0 +1,140 @@
+package core
+
+// SynergyRule gives extra yield to a Territory based on its neighbours on the MapGrid.
+type SynergyRule interface {
+	// Bonus returns the extra yield of the controlled Territory at (x, y).
+	Bonus(ctx *SynergyContext, x, y int) ResourceQuantity
+}
+
+// SynergyContext gives SynergyRules access to the MapGrid.
+// The StructureCards of one Territory can be replaced by a planned construction.
+type SynergyContext struct {
+	mapGrid    *MapGrid
+	planned    bool
+	planX      int
+	planY      int
+	plannedSet []*StructureCard
+}
+
+// Cards returns the StructureCards of the controlled Territory at (x, y), or false if there is none.
+func (c *SynergyContext) Cards(x, y int) ([]*StructureCard, bool) {
+	point, ok := c.mapGrid.GetPoint(x, y)
+	if !ok {
+		return nil, false
+	}
+	wilderness, ok := point.(*WildernessPoint)
+	if !ok || !wilderness.controlled || wilderness.territory == nil {
+		return nil, false
+	}
+
+	if c.planned && x == c.planX && y == c.planY {
+		return c.plannedSet, true
+	}
+	return wilderness.territory.Cards(), true
+}
+
+// PointType returns the PointType at (x, y).
+func (c *SynergyContext) PointType(x, y int) PointType {
+	point, ok := c.mapGrid.GetPoint(x, y)
+	if !ok || point == nil {
+		return PointTypeUnknown
+	}
+	return point.PointType()
+}
+
+// neighbourOffsets are the offsets of the adjacent points.
+var neighbourOffsets = [4][2]int{{-1, 0}, {0, -1}, {1, 0}, {0, 1}}
+
+// SynergyRuleNeighbourCard gives Yield for each CardID in the Territory and each adjacent Territory having NeighbourCardID.
+type SynergyRuleNeighbourCard struct {
+	CardID          CardID
+	NeighbourCardID CardID
+	Yield           ResourceQuantity
+}
+
+func (r *SynergyRuleNeighbourCard) Bonus(ctx *SynergyContext, x, y int) ResourceQuantity {
+	cards, ok := ctx.Cards(x, y)
+	if !ok {
+		return ResourceQuantity{}
+	}
+	count := countCards(cards, r.CardID)
+	if count == 0 {
+		return ResourceQuantity{}
+	}
+
+	neighbours := 0
+	for _, d := range neighbourOffsets {
+		neighbourCards, ok := ctx.Cards(x+d[0], y+d[1])
+		if ok && countCards(neighbourCards, r.NeighbourCardID) > 0 {
+			neighbours++
+		}
+	}
+	return r.Yield.Scale(float64(count * neighbours))
+}
+
+// SynergyRuleNeighbourPoint gives Yield for each CardID in the Territory if it is adjacent to any of PointTypes.
+type SynergyRuleNeighbourPoint struct {
+	CardID     CardID
+	PointTypes []PointType
+	Yield      ResourceQuantity
+}
+
+func (r *SynergyRuleNeighbourPoint) Bonus(ctx *SynergyContext, x, y int) ResourceQuantity {
+	cards, ok := ctx.Cards(x, y)
+	if !ok {
+		return ResourceQuantity{}
+	}
+	count := countCards(cards, r.CardID)
+	if count == 0 {
+		return ResourceQuantity{}
+	}
+
+	for _, d := range neighbourOffsets {
+		pointType := ctx.PointType(x+d[0], y+d[1])
+		for _, t := range r.PointTypes {
+			if pointType == t {
+				return r.Yield.Scale(float64(count))
+			}
+		}
+	}
+	return ResourceQuantity{}
+}
+
+func countCards(cards []*StructureCard, cardID CardID) int {
+	count := 0
+	for _, card := range cards {
+		if card.ID() == cardID {
+			count++
+		}
+	}
+	return count
+}
+
+// SynergyBonus returns the extra yield of the Territory at (x, y) given by the SynergyRules.
+func (m *MapGrid) SynergyBonus(x, y int) ResourceQuantity {
+	return m.synergyBonus(&SynergyContext{mapGrid: m}, x, y)
+}
+
+// PredictedSynergyBonus returns the change of the synergy yield on the whole MapGrid
+// if the StructureCards of the Territory at (x, y) were replaced by cards.
+// It includes the bonus of the Territory itself and the changes in the adjacent Territories.
+func (m *MapGrid) PredictedSynergyBonus(x, y int, cards []*StructureCard) ResourceQuantity {
+	current := &SynergyContext{mapGrid: m}
+	planned := &SynergyContext{mapGrid: m, planned: true, planX: x, planY: y, plannedSet: cards}
+
+	bonus := m.synergyBonus(planned, x, y)
+	for _, d := range neighbourOffsets {
+		nx, ny := x+d[0], y+d[1]
+		bonus = bonus.Add(m.synergyBonus(planned, nx, ny))
+		bonus = bonus.Add(m.synergyBonus(current, nx, ny).Scale(-1))
+	}
+	return bonus
+}
+
+func (m *MapGrid) synergyBonus(ctx *SynergyContext, x, y int) ResourceQuantity {
+	bonus := ResourceQuantity{}
+	for _, rule := range m.SynergyRules {
+		bonus = bonus.Add(rule.Bonus(ctx, x, y))
+	}
+	return bonus
+}
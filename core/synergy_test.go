@@ -0,0 +1,94 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+func TestMapGrid_SynergyBonus(t *testing.T) {
+	woodcutter := core.NewStructureCard("structurecard-woodcutter", core.ResourceQuantity{Wood: 2}, core.ResourceModifier{}, 0, 0)
+	sawmill := core.NewStructureCard("structurecard-sawmill", core.ResourceQuantity{}, core.ResourceModifier{}, 0, 0)
+	market := core.NewStructureCard("structurecard-market", core.ResourceQuantity{Money: 5}, core.ResourceModifier{}, 0, 0)
+
+	newWilderness := func(controlled bool, cards ...*core.StructureCard) *core.WildernessPoint {
+		territory := core.NewTerritory("territory", core.NewTerrain("terrain", core.ResourceQuantity{}, 3))
+		plan := core.NewConstructionPlan(territory)
+		for _, card := range cards {
+			plan.AddCard(card)
+		}
+		territory.ApplyConstructionPlan(plan)
+
+		point := &core.WildernessPoint{}
+		point.SetControlledForTest(controlled)
+		point.SetTerritoryForTest(territory)
+		return point
+	}
+
+	// Layout (3x2):
+	// MyNation   | woodcutter | sawmill
+	// market     | woodcutter | (uncontrolled sawmill)
+	points := []core.Point{
+		&core.MyNationPoint{MyNation: core.NewMyNation("player", "My Nation")},
+		newWilderness(true, woodcutter),
+		newWilderness(true, sawmill),
+		newWilderness(true, market),
+		newWilderness(true, woodcutter, woodcutter),
+		newWilderness(false, sawmill),
+	}
+	mapGrid := &core.MapGrid{
+		Size:   core.MapGridSize{X: 3, Y: 2},
+		Points: points,
+		SynergyRules: []core.SynergyRule{
+			&core.SynergyRuleNeighbourCard{
+				CardID:          "structurecard-woodcutter",
+				NeighbourCardID: "structurecard-sawmill",
+				Yield:           core.ResourceQuantity{Wood: 1},
+			},
+			&core.SynergyRuleNeighbourPoint{
+				CardID:     "structurecard-market",
+				PointTypes: []core.PointType{core.PointTypeMyNation},
+				Yield:      core.ResourceQuantity{Money: 2},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		x, y int
+		want core.ResourceQuantity
+	}{
+		{"woodcutter next to a sawmill", 1, 0, core.ResourceQuantity{Wood: 1}},
+		{"uncontrolled sawmill does not count", 1, 1, core.ResourceQuantity{}},
+		{"market next to the nation", 0, 1, core.ResourceQuantity{Money: 2}},
+		{"sawmill itself", 2, 0, core.ResourceQuantity{}},
+		{"nation point", 0, 0, core.ResourceQuantity{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapGrid.SynergyBonus(tt.x, tt.y); got != tt.want {
+				t.Errorf("SynergyBonus(%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+
+	// Building a sawmill next to the territory with two woodcutters boosts both of them.
+	predicted := mapGrid.PredictedSynergyBonus(0, 1, []*core.StructureCard{market, sawmill})
+	want := core.ResourceQuantity{Money: 2, Wood: 2}
+	if predicted != want {
+		t.Errorf("PredictedSynergyBonus() = %v, want %v", predicted, want)
+	}
+
+	// Removing the sawmill loses the bonus of the neighbouring woodcutter.
+	predicted = mapGrid.PredictedSynergyBonus(2, 0, nil)
+	want = core.ResourceQuantity{Wood: -1}
+	if predicted != want {
+		t.Errorf("PredictedSynergyBonus() = %v, want %v", predicted, want)
+	}
+
+	gameState := &core.GameState{MapGrid: mapGrid, Treasury: &core.Treasury{}}
+	yield := gameState.GetYield()
+	if yield.Wood != 2+2*2+1 || yield.Money != 5+2 {
+		t.Errorf("GetYield() = %v, want Wood 7 and Money 7", yield)
+	}
+}
@@ -1,5 +1,7 @@
 package core
 
+import "slices"
+
 // TerritoryID is a unique identifier for a territory.
 type TerritoryID string
 
@@ -8,17 +10,24 @@ type TerrainID string
 
 // Terrain represents the immutable properties of a terrain type.
 type Terrain struct {
-	id        TerrainID
-	baseYield ResourceQuantity
-	cardSlot  int
+	id            TerrainID
+	baseYield     ResourceQuantity
+	cardSlot      int
+	battleEffects []*TerrainBattleEffect
 }
 
 // NewTerrain creates a new Terrain instance.
 func NewTerrain(id TerrainID, baseYield ResourceQuantity, cardSlot int) *Terrain {
+	return NewTerrainWithBattleEffects(id, baseYield, cardSlot, nil)
+}
+
+// NewTerrainWithBattleEffects creates a new Terrain instance that affects the battles fought on it.
+func NewTerrainWithBattleEffects(id TerrainID, baseYield ResourceQuantity, cardSlot int, battleEffects []*TerrainBattleEffect) *Terrain {
 	return &Terrain{
-		id:        id,
-		baseYield: baseYield,
-		cardSlot:  cardSlot,
+		id:            id,
+		baseYield:     baseYield,
+		cardSlot:      cardSlot,
+		battleEffects: battleEffects,
 	}
 }
 
@@ -37,21 +46,75 @@ func (t *Terrain) CardSlot() int {
 	return t.cardSlot
 }
 
+// BattleEffects returns the effects on the battles fought on this terrain.
+func (t *Terrain) BattleEffects() []*TerrainBattleEffect {
+	return t.battleEffects
+}
+
+// TerrainBattleEffect is an effect of a Terrain on the battles fought on it.
+type TerrainBattleEffect struct {
+	DescriptionKey string                   // DescriptionKey is the lang key of the description.
+	CardType       BattleCardType           // CardType is the type of the affected BattleCards. Empty means all types.
+	Modifier       *BattleCardPowerModifier // Modifier is applied to the affected BattleCards. It can be nil.
+	CardSlotDelta  int                      // CardSlotDelta changes the CardSlot of the Battlefield.
+}
+
+// Affects returns true if the effect modifies the power of the BattleCard.
+func (e *TerrainBattleEffect) Affects(card *BattleCard) bool {
+	if e.Modifier == nil {
+		return false
+	}
+	return e.CardType == "" || e.CardType == card.Type
+}
+
+// TerritoryLevel is a development level of a Territory.
+type TerritoryLevel struct {
+	Cost          ResourceQuantity // Cost is the price to reach the level by spending resources.
+	HeldTurns     Turn             // HeldTurns is the number of turns held after which the level is reached for free. 0 means never.
+	CardSlotBonus int              // CardSlotBonus is the number of additional card slots at the level.
+	YieldModifier ResourceModifier // YieldModifier raises the base yield at the level.
+}
+
+// TerrainUpgrade changes the Terrain of a Territory, e.g. from plain to farmland.
+type TerrainUpgrade struct {
+	From     TerrainID        // From is the Terrain that can be upgraded.
+	To       *Terrain         // To is the Terrain after the upgrade.
+	Cost     ResourceQuantity // Cost is the price of the upgrade.
+	MinLevel int              // MinLevel is the level of the Territory required for the upgrade.
+}
+
+// TerritoryDevelopment is the set of rules for developing Territories.
+type TerritoryDevelopment struct {
+	Levels   []*TerritoryLevel // Levels[i] is the level i+2. Level 1 has no bonus.
+	Upgrades []*TerrainUpgrade
+}
+
 // Territory is a conquered WildernessPoint.
 // A Territory acquires Resources equal to its Yield each turn.
 // StructureCards can be placed in a Territory.
+// A Territory levels up by spending resources or by being held for some turns.
 type Territory struct {
-	id      TerritoryID
-	terrain *Terrain
-	cards   []*StructureCard
+	id          TerritoryID
+	terrain     *Terrain
+	cards       []*StructureCard
+	development *TerritoryDevelopment
+	level       int
+	heldTurns   Turn
 }
 
 // NewTerritory creates a new Territory instance.
 func NewTerritory(id TerritoryID, terrain *Terrain) *Territory {
+	return NewTerritoryWithDevelopment(id, terrain, nil)
+}
+
+// NewTerritoryWithDevelopment creates a new Territory instance that can be developed.
+func NewTerritoryWithDevelopment(id TerritoryID, terrain *Terrain, development *TerritoryDevelopment) *Territory {
 	return &Territory{
-		id:      id,
-		terrain: terrain,
-		cards:   make([]*StructureCard, 0, terrain.CardSlot()),
+		id:          id,
+		terrain:     terrain,
+		cards:       make([]*StructureCard, 0, terrain.CardSlot()),
+		development: development,
+		level:       1,
 	}
 }
 
@@ -65,6 +128,101 @@ func (t *Territory) Terrain() *Terrain {
 	return t.terrain
 }
 
+// Level returns the development level of this territory. It starts at 1.
+func (t *Territory) Level() int {
+	return t.level
+}
+
+// HeldTurns returns the number of turns this territory has been held.
+func (t *Territory) HeldTurns() Turn {
+	return t.heldTurns
+}
+
+// NextLevel returns the next development level, or false if the territory is at the max level.
+func (t *Territory) NextLevel() (*TerritoryLevel, bool) {
+	if t.development == nil || t.level-1 >= len(t.development.Levels) {
+		return nil, false
+	}
+	return t.development.Levels[t.level-1], true
+}
+
+// currentLevel returns the current development level, or nil at level 1.
+func (t *Territory) currentLevel() *TerritoryLevel {
+	if t.development == nil || t.level < 2 {
+		return nil
+	}
+	return t.development.Levels[t.level-2]
+}
+
+// CardSlot returns the number of card slots including the level bonus.
+func (t *Territory) CardSlot() int {
+	cardSlot := t.terrain.CardSlot()
+	if level := t.currentLevel(); level != nil {
+		cardSlot += level.CardSlotBonus
+	}
+	return cardSlot
+}
+
+// BaseYield returns the base yield of the terrain raised by the level.
+func (t *Territory) BaseYield() ResourceQuantity {
+	baseYield := t.terrain.BaseYield()
+	if level := t.currentLevel(); level != nil {
+		baseYield = level.YieldModifier.Modify(baseYield)
+	}
+	return baseYield
+}
+
+// LevelUp pays the cost of the next level from the treasury and raises the level.
+func (t *Territory) LevelUp(treasury *Treasury) bool {
+	next, ok := t.NextLevel()
+	if !ok {
+		return false
+	}
+	if !treasury.Sub(next.Cost) {
+		return false
+	}
+	t.level++
+	return true
+}
+
+// Hold counts a turn held and raises the level for free when enough turns have passed.
+func (t *Territory) Hold() {
+	t.heldTurns++
+	for {
+		next, ok := t.NextLevel()
+		if !ok || next.HeldTurns <= 0 || t.heldTurns < next.HeldTurns {
+			return
+		}
+		t.level++
+	}
+}
+
+// TerrainUpgrade returns the upgrade available for the current terrain.
+func (t *Territory) TerrainUpgrade() (*TerrainUpgrade, bool) {
+	if t.development == nil {
+		return nil, false
+	}
+	for _, upgrade := range t.development.Upgrades {
+		if upgrade.From == t.terrain.ID() {
+			return upgrade, true
+		}
+	}
+	return nil, false
+}
+
+// UpgradeTerrain pays the cost of the TerrainUpgrade from the treasury and changes the terrain.
+func (t *Territory) UpgradeTerrain(treasury *Treasury) bool {
+	upgrade, ok := t.TerrainUpgrade()
+	if !ok || t.level < upgrade.MinLevel {
+		return false
+	}
+	if !treasury.Sub(upgrade.Cost) {
+		return false
+	}
+	t.terrain = upgrade.To
+	return true
+}
+
 // Cards returns a defensive copy of the structure cards in this territory.
 func (t *Territory) Cards() []*StructureCard {
 	result := make([]*StructureCard, len(t.cards))
@@ -74,7 +232,7 @@ func (t *Territory) Cards() []*StructureCard {
 
 // AppendCard places a StructureCard in the territory.
 func (t *Territory) AppendCard(card *StructureCard) bool {
-	if len(t.cards) >= t.terrain.CardSlot() {
+	if len(t.cards) >= t.CardSlot() {
 		return false // Slot limit reached
 	}
 	t.cards = append(t.cards, card)
@@ -94,16 +252,16 @@ func (t *Territory) RemoveCard(index int) (*StructureCard, bool) {
 
 // Yield returns the result of passing the BaseYield through the yield effects of the placed StructureCards.
 func (t *Territory) Yield() ResourceQuantity {
-	yield := t.terrain.BaseYield()
+	yield := t.BaseYield()
 
 	// Apply additive effects first
 	for _, card := range t.cards {
-		yield = yield.Add(card.YieldAdditiveValue())
+		yield = card.AddYield(yield)
 	}
 
 	// Apply multiplicative effects
 	for _, card := range t.cards {
-		yield = card.YieldModifier().Modify(yield)
+		yield = card.ModifyYield(yield)
 	}
 
 	return yield
@@ -154,16 +312,31 @@ func (cp *ConstructionPlan) Cards() []*StructureCard {
 }
 
 func (cp *ConstructionPlan) CanPlaceCard() bool {
-	return len(cp.cards) < cp.territory.Terrain().CardSlot()
+	return len(cp.cards) < cp.territory.CardSlot()
 }
 
-// AddCard adds a StructureCard to the construction plan.
+// AddCard adds a StructureCard to the end of the construction plan.
 func (cp *ConstructionPlan) AddCard(card *StructureCard) bool {
-	// Card slot limit check should be done by the caller
-	cp.cards = append(cp.cards, card)
+	return cp.InsertCard(len(cp.cards), card)
+}
+
+// InsertCard places a StructureCard at the index and shifts the following cards.
+// The index is clamped to the planned cards, so an index past the end appends the card.
+// It returns false if the card slots of the territory are full.
+func (cp *ConstructionPlan) InsertCard(index int, card *StructureCard) bool {
+	if !cp.CanPlaceCard() {
+		return false
+	}
+	index = min(max(index, 0), len(cp.cards))
+	cp.cards = slices.Insert(cp.cards, index, card)
 	return true
 }
 
+// MoveCard moves the StructureCard at from so that it ends up at to. The cards in between shift by one.
+func (cp *ConstructionPlan) MoveCard(from, to int) bool {
+	return moveElement(cp.cards, from, to)
+}
+
 // RemoveCard removes a StructureCard at the specified index from the construction plan.
 func (cp *ConstructionPlan) RemoveCard(index int) (*StructureCard, bool) {
 	if index < 0 || index >= len(cp.cards) {
@@ -175,12 +348,12 @@ func (cp *ConstructionPlan) RemoveCard(index int) (*StructureCard, bool) {
 }
 
 func (cp *ConstructionPlan) Yield() ResourceQuantity {
-	yield := cp.territory.Terrain().BaseYield()
+	yield := cp.territory.BaseYield()
 	for _, card := range cp.cards {
-		yield = yield.Add(card.YieldAdditiveValue())
+		yield = card.AddYield(yield)
 	}
 	for _, card := range cp.cards {
-		yield = card.YieldModifier().Modify(yield)
+		yield = card.ModifyYield(yield)
 	}
 	return yield
 }
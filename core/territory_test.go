@@ -1,6 +1,7 @@
 package core_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
@@ -245,4 +246,100 @@ func TestTerritory_Basic(t *testing.T) {
 	}
 }
 
+func TestTerritory_Development(t *testing.T) {
+	farmland := core.NewTerrain("farmland", core.ResourceQuantity{Food: 8}, 2)
+	development := &core.TerritoryDevelopment{
+		Levels: []*core.TerritoryLevel{
+			{Cost: core.ResourceQuantity{Money: 10}, HeldTurns: 3, CardSlotBonus: 1, YieldModifier: core.ResourceModifier{Food: 0.5}},
+			{Cost: core.ResourceQuantity{Money: 20}, CardSlotBonus: 2, YieldModifier: core.ResourceModifier{Food: 1.0}},
+		},
+		Upgrades: []*core.TerrainUpgrade{
+			{From: "plain", To: farmland, Cost: core.ResourceQuantity{Money: 5}, MinLevel: 2},
+		},
+	}
+	territory := core.NewTerritoryWithDevelopment("territory", core.NewTerrain("plain", core.ResourceQuantity{Food: 4}, 2), development)
+	treasury := &core.Treasury{Resources: core.ResourceQuantity{Money: 30}}
+
+	if territory.Level() != 1 || territory.CardSlot() != 2 {
+		t.Fatalf("Level()/CardSlot() = %d/%d, want 1/2", territory.Level(), territory.CardSlot())
+	}
+	if territory.UpgradeTerrain(treasury) {
+		t.Errorf("UpgradeTerrain() = true below MinLevel, want false")
+	}
+
+	// Holding the territory levels it up for free.
+	for range 3 {
+		territory.Hold()
+	}
+	if territory.Level() != 2 {
+		t.Errorf("Level() = %d after 3 turns held, want 2", territory.Level())
+	}
+	if territory.CardSlot() != 3 {
+		t.Errorf("CardSlot() = %d, want 3", territory.CardSlot())
+	}
+	if got := territory.Yield(); got != (core.ResourceQuantity{Food: 6}) {
+		t.Errorf("Yield() = %v, want Food 6", got)
+	}
+
+	if !territory.UpgradeTerrain(treasury) {
+		t.Fatalf("UpgradeTerrain() = false, want true")
+	}
+	if territory.Terrain() != farmland || treasury.Resources.Money != 25 {
+		t.Errorf("Terrain()/Money = %v/%d, want farmland/25", territory.Terrain().ID(), treasury.Resources.Money)
+	}
+	if _, ok := territory.TerrainUpgrade(); ok {
+		t.Errorf("TerrainUpgrade() should not be available for farmland")
+	}
+
+	// Spending resources levels it up.
+	if !territory.LevelUp(treasury) {
+		t.Fatalf("LevelUp() = false, want true")
+	}
+	if territory.Level() != 3 || territory.CardSlot() != 4 || treasury.Resources.Money != 5 {
+		t.Errorf("Level()/CardSlot()/Money = %d/%d/%d, want 3/4/5", territory.Level(), territory.CardSlot(), treasury.Resources.Money)
+	}
+	if got := territory.Yield(); got != (core.ResourceQuantity{Food: 16}) {
+		t.Errorf("Yield() = %v, want Food 16", got)
+	}
+	if territory.LevelUp(treasury) {
+		t.Errorf("LevelUp() = true at the max level, want false")
+	}
+}
+
 // Note: mockYieldModifier is no longer needed as we use ResourceModifier directly
+
+func TestConstructionPlan_InsertAndMoveCard(t *testing.T) {
+	territory := core.NewTerritory("territory", core.NewTerrain("terrain", core.ResourceQuantity{}, 3))
+	plan := core.NewConstructionPlan(territory)
+	for _, id := range []core.CardID{"a", "b"} {
+		plan.AddCard(core.NewStructureCard(id, core.ResourceQuantity{}, core.NewResourceModifier(), 0.0, 0))
+	}
+
+	if !plan.InsertCard(1, core.NewStructureCard("c", core.ResourceQuantity{}, core.NewResourceModifier(), 0.0, 0)) {
+		t.Fatalf("InsertCard(1) = false, want true")
+	}
+	if got, want := structureCardIDs(plan.Cards()), []core.CardID{"a", "c", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Cards() after InsertCard = %v, want %v", got, want)
+	}
+	if plan.InsertCard(0, core.NewStructureCard("d", core.ResourceQuantity{}, core.NewResourceModifier(), 0.0, 0)) {
+		t.Errorf("InsertCard() over the card slot = true, want false")
+	}
+
+	if !plan.MoveCard(0, 2) {
+		t.Fatalf("MoveCard(0, 2) = false, want true")
+	}
+	if got, want := structureCardIDs(plan.Cards()), []core.CardID{"c", "b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("Cards() after MoveCard = %v, want %v", got, want)
+	}
+	if plan.MoveCard(-1, 0) {
+		t.Errorf("MoveCard(-1, 0) = true, want false")
+	}
+}
+
+func structureCardIDs(cards []*core.StructureCard) []core.CardID {
+	ids := make([]core.CardID, len(cards))
+	for i, card := range cards {
+		ids[i] = card.ID()
+	}
+	return ids
+}
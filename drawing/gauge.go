@@ -1,8 +1,6 @@
 package drawing
 
 import (
-	"fmt"
-
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/geom"
 )
@@ -10,6 +8,7 @@ import (
 type GaugeDrawer struct {
 	Max           int
 	Current       int
+	Text          string // Text is the value drawn on the gauge, already formatted by the caller.
 	TopLeft       geom.PointF
 	BottomRight   geom.PointF
 	TextOffset    geom.PointF
@@ -24,7 +23,7 @@ func (d *GaugeDrawer) Draw(screen *ebiten.Image) {
 	opt := ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(d.TopLeft.X+d.TextOffset.X, d.TopLeft.Y+d.TextOffset.Y)
 
-	DrawText(screen, fmt.Sprint(d.Current), d.FontSize, &opt)
+	DrawText(screen, d.Text, d.FontSize, &opt)
 }
 
 func (d *GaugeDrawer) drawRect(screen *ebiten.Image) {
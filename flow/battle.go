@@ -9,17 +9,19 @@ import (
 type BattleFlow struct {
 	gameState   *core.GameState
 	battlefield *core.Battlefield
+	intner      core.Intner
 }
 
 // NewBattleFlow creates a new BattleFlow
-func NewBattleFlow(gameState *core.GameState) *BattleFlow {
+func NewBattleFlow(gameState *core.GameState, intner core.Intner) *BattleFlow {
 	return &BattleFlow{
 		gameState: gameState,
+		intner:    intner,
 	}
 }
 
 func (bf *BattleFlow) Select(x, y int) (*viewmodel.BattleViewModel, bool) {
-	ok := bf.gameState.InitBattlefield(x, y)
+	ok := bf.gameState.InitBattlefield(x, y, bf.intner)
 	if !ok {
 		return nil, false
 	}
@@ -54,34 +56,53 @@ func (bf *BattleFlow) RemoveFromBattle(cardIndex int) bool {
 	return true
 }
 
-// Conquer attempts to conquer the current battle point
-func (bf *BattleFlow) Conquer() bool {
+// MoveInBattle moves a placed card from one position to another
+func (bf *BattleFlow) MoveInBattle(from, to int) bool {
 	battlefield, ok := bf.gameState.Battlefield()
 	if !ok {
 		return false
 	}
+	return battlefield.MoveBattleCard(from, to)
+}
 
-	if !battlefield.CanBeat() {
-		return false
+// Conquer fights the current battle. Surviving cards return to the deck, and the point is conquered on a victory.
+func (bf *BattleFlow) Conquer() (*viewmodel.BattleResultViewModel, bool) {
+	result, ok := bf.gameState.ResolveBattle(bf.intner)
+	if !ok {
+		return nil, false
 	}
 
-	// Mark conquest on game state
-	bf.gameState.Conquer()
-	return true
+	return viewmodel.NewBattleResultViewModel(result), true
 }
 
-// Rollback returns all cards from battlefield to deck and resets battlefield
-func (bf *BattleFlow) Rollback() {
+// NextBossPhase moves to the next phase of a boss if the current phase is beaten.
+// The used cards stay on the battlefield and do not return to the deck.
+func (bf *BattleFlow) NextBossPhase() (*viewmodel.BattleViewModel, bool) {
+	if !bf.gameState.NextBossPhase(bf.intner) {
+		return nil, false
+	}
+
 	battlefield, ok := bf.gameState.Battlefield()
 	if !ok {
-		return
+		return nil, false
 	}
+	bf.battlefield = battlefield
 
-	// Return all cards to deck
-	for _, card := range battlefield.BattleCards {
-		bf.gameState.CardDeck.Add(card.CardID)
+	return viewmodel.NewBattleViewModel(bf.gameState, bf.battlefield, bf.battlefield.Point), true
+}
+
+// Attack finishes the current round of a multi-round battle
+func (bf *BattleFlow) Attack() bool {
+	battlefield, ok := bf.gameState.Battlefield()
+	if !ok {
+		return false
 	}
 
-	// Clear battlefield
-	battlefield.BattleCards = make([]*core.BattleCard, 0)
+	return battlefield.Attack()
+}
+
+// Rollback cancels the battle. Only the cards of the current round return to the deck.
+func (bf *BattleFlow) Rollback() {
+	bf.gameState.RetreatBattle()
+	bf.battlefield = nil
 }
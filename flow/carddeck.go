@@ -16,7 +16,17 @@ func NewCardDeckFlow(gameState *core.GameState) *CardDeckFlow {
 	}
 }
 
+// PlayBattleCardInBattle places a battle card from the deck at the end of the battlefield.
 func (f *CardDeckFlow) PlayBattleCardInBattle(id core.CardID) {
+	battlefield, ok := f.gameState.Battlefield()
+	if !ok {
+		return
+	}
+	f.PlayBattleCardInBattleAt(id, len(battlefield.BattleCards))
+}
+
+// PlayBattleCardInBattleAt places a battle card from the deck at the index of the battlefield.
+func (f *CardDeckFlow) PlayBattleCardInBattleAt(id core.CardID, index int) {
 	battleCard, ok := f.gameState.CardDictionary.BattleCard(id)
 	if !ok {
 		return
@@ -31,11 +41,27 @@ func (f *CardDeckFlow) PlayBattleCardInBattle(id core.CardID) {
 		return
 	}
 
-	battlefield.AddBattleCard(battleCard)
+	if !battlefield.InsertBattleCard(index, battleCard) {
+		return
+	}
 	f.gameState.CardDeck.Remove(id)
+	f.gameState.Notify(&core.Event{
+		Type:        core.EventTypeCardPlayed,
+		BattleCards: []*core.BattleCard{battleCard},
+	})
 }
 
+// PlayStructureCardInTerritory places a structure card from the deck at the end of the construction plan.
 func (f *CardDeckFlow) PlayStructureCardInTerritory(id core.CardID) {
+	plan, ok := f.gameState.ConstructionPlan()
+	if !ok {
+		return
+	}
+	f.PlayStructureCardInTerritoryAt(id, len(plan.Cards()))
+}
+
+// PlayStructureCardInTerritoryAt places a structure card from the deck at the index of the construction plan.
+func (f *CardDeckFlow) PlayStructureCardInTerritoryAt(id core.CardID, index int) {
 	structureCard, ok := f.gameState.CardDictionary.StructureCard(id)
 	if !ok {
 		return
@@ -49,6 +75,12 @@ func (f *CardDeckFlow) PlayStructureCardInTerritory(id core.CardID) {
 	if !ok {
 		return
 	}
-	plan.AddCard(structureCard)
+	if !plan.InsertCard(index, structureCard) {
+		return
+	}
 	f.gameState.CardDeck.Remove(id)
+	f.gameState.Notify(&core.Event{
+		Type:          core.EventTypeCardPlayed,
+		StructureCard: structureCard,
+	})
 }
@@ -70,11 +70,14 @@ func (mf *MarketFlow) Purchase(marketItemIdx int) bool {
 
 	// Process card pack if purchased
 	if cardPack != nil {
+		if mf.nation != nil {
+			mf.gameState.AddHistory(core.NewPurchaseHistory(mf.gameState.CurrentTurn, mf.nation.ID(), cardPack))
+		}
 		mf.processCardPack(cardPack)
 	}
 
 	// Advance turn
-	mf.gameState.NextTurn()
+	mf.gameState.NextTurn(mf.intner)
 
 	return true
 }
@@ -94,14 +97,7 @@ func (mf *MarketFlow) addMarketLevelHistory() {
 		return
 	}
 
-	mf.gameState.AddHistory(core.History{
-		Turn: mf.gameState.CurrentTurn,
-		Key:  "history-market",
-		Data: map[string]any{
-			"nation": string(mf.nation.ID()),
-			"level":  int(mf.market.Level),
-		},
-	})
+	mf.gameState.AddHistory(core.NewMarketLevelHistory(mf.gameState.CurrentTurn, mf.nation.ID(), int(mf.market.Level)))
 }
 
 // processCardPack opens a card pack and adds cards to the deck
@@ -112,6 +108,12 @@ func (mf *MarketFlow) processCardPack(cardPack *core.CardPack) {
 
 	// Open card pack
 	cardIDs := cardPack.Open(mf.intner)
+	mf.gameState.Stats.PacksOpened++
+	mf.gameState.AddHistory(core.NewPackContentsHistory(mf.gameState.CurrentTurn, cardPack, cardIDs))
+	mf.gameState.Notify(&core.Event{
+		Type:     core.EventTypePackOpened,
+		CardPack: cardPack,
+	})
 
 	// Add cards to deck
 	for _, cardID := range cardIDs {
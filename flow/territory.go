@@ -30,9 +30,15 @@ func (tf *TerritoryFlow) SelectTerritory(x, y int) (*viewmodel.TerritoryViewMode
 		return nil, false
 	}
 
+	// The plan is shared with CardDeckFlow, which places cards from the deck.
+	tf.gameState.InitConstructionPlan(x, y)
+	plan, ok := tf.gameState.ConstructionPlan()
+	if !ok {
+		return nil, false
+	}
 	tf.territory = territoryPoint.Territory()
-	tf.currentPlan = core.NewConstructionPlan(tf.territory)
-	vm := viewmodel.NewTerritoryViewModel(tf.territory, tf.currentPlan)
+	tf.currentPlan = plan
+	vm := viewmodel.NewTerritoryViewModel(tf.gameState, x, y, tf.territory, tf.currentPlan)
 	return vm, true
 }
 
@@ -73,14 +79,54 @@ func (tf *TerritoryFlow) RemoveFromPlan(cardIndex int) bool {
 	return true
 }
 
+// MoveInPlan moves a planned card from one position to another
+func (tf *TerritoryFlow) MoveInPlan(from, to int) bool {
+	if tf.currentPlan == nil {
+		return false
+	}
+	return tf.currentPlan.MoveCard(from, to)
+}
+
+// LevelUp pays the cost of the next level of the territory
+func (tf *TerritoryFlow) LevelUp() bool {
+	if tf.territory == nil {
+		return false
+	}
+	return tf.territory.LevelUp(tf.gameState.Treasury)
+}
+
+// UpgradeTerrain pays the cost of the terrain upgrade of the territory
+func (tf *TerritoryFlow) UpgradeTerrain() bool {
+	if tf.territory == nil {
+		return false
+	}
+	return tf.territory.UpgradeTerrain(tf.gameState.Treasury)
+}
+
 // Commit applies the construction plan to the territory
 func (tf *TerritoryFlow) Commit() {
 	if tf.currentPlan == nil {
 		return
 	}
 
+	// Only a plan that changes the cards is recorded in the history
+	changed := false
+	for _, delta := range tf.currentPlan.GetRollbackCards() {
+		if delta != 0 {
+			changed = true
+		}
+	}
+
 	tf.territory.ApplyConstructionPlan(tf.currentPlan)
 	tf.currentPlan = nil
+	if changed {
+		tf.gameState.AddHistory(core.NewConstructionHistory(tf.gameState.CurrentTurn, tf.territory))
+	}
+
+	tf.gameState.Notify(&core.Event{
+		Type:      core.EventTypeConstructionCommitted,
+		Territory: tf.territory,
+	})
 }
 
 // Rollback reverts all changes to the original state
@@ -0,0 +1,49 @@
+package flow_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/flow"
+)
+
+func TestTerritoryFlow_SharesPlanWithCardDeckFlow(t *testing.T) {
+	terrain := core.NewTerrain("terrain", core.ResourceQuantity{Money: 1}, 2)
+	territory := core.NewTerritory("territory", terrain)
+	wilderness := &core.WildernessPoint{}
+	wilderness.SetControlledForTest(true)
+	wilderness.SetTerritoryForTest(territory)
+
+	card := core.NewStructureCard("structure", core.ResourceQuantity{Money: 1}, core.ResourceModifier{}, 0, 0)
+	deck := core.NewCardDeck()
+	deck.Add(card.ID())
+
+	gameState := &core.GameState{
+		MapGrid: &core.MapGrid{
+			Size:   core.MapGridSize{X: 1, Y: 1},
+			Points: []core.Point{wilderness},
+		},
+		CardDictionary: core.NewCardDictionary(nil, []*core.StructureCard{card}),
+		CardDeck:       deck,
+		Treasury:       &core.Treasury{},
+	}
+
+	territoryFlow := flow.NewTerritoryFlow(gameState)
+	cardDeckFlow := flow.NewCardDeckFlow(gameState)
+
+	if _, ok := territoryFlow.SelectTerritory(0, 0); !ok {
+		t.Fatal("SelectTerritory() should succeed")
+	}
+
+	cardDeckFlow.PlayStructureCardInTerritory(card.ID())
+
+	if deck.Count(card.ID()) != 0 {
+		t.Errorf("Count() = %d, want 0", deck.Count(card.ID()))
+	}
+
+	territoryFlow.Commit()
+	cards := territory.Cards()
+	if len(cards) != 1 || cards[0] != card {
+		t.Errorf("Cards() = %v, want [%v]", cards, card)
+	}
+}
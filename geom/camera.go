@@ -0,0 +1,116 @@
+package geom
+
+// Camera converts between world coordinates and the screen coordinates of a viewport, with a pan position and a zoom scale.
+type Camera struct {
+	Viewport Rect    // Viewport is the area of the screen where the world is drawn.
+	World    Rect    // World is the area which can be shown. The camera does not pan beyond it.
+	Position PointF  // Position is the world point shown at the top left of the viewport.
+	Zoom     float64 // Zoom is the number of screen pixels per world unit.
+	MaxZoom  float64 // MaxZoom is the largest Zoom. The smallest one fits the whole world in the viewport.
+}
+
+// NewCamera creates a Camera showing the top left of the world at zoom 1.
+func NewCamera(viewport, world Rect, maxZoom float64) *Camera {
+	c := &Camera{
+		Viewport: viewport,
+		World:    world,
+		Position: world.TopLeft(),
+		Zoom:     1,
+		MaxZoom:  maxZoom,
+	}
+	c.clamp()
+	return c
+}
+
+// MinZoom returns the zoom at which the whole world fits in the viewport. A world smaller than the viewport is not enlarged.
+func (c *Camera) MinZoom() float64 {
+	if c.World.Width <= 0 || c.World.Height <= 0 {
+		return 1
+	}
+	return min(c.Viewport.Width/c.World.Width, c.Viewport.Height/c.World.Height, 1)
+}
+
+// SetWorld changes the area which can be shown, keeping the camera inside it.
+func (c *Camera) SetWorld(world Rect) {
+	if c.World == world {
+		return
+	}
+	c.World = world
+	c.clamp()
+}
+
+// ToScreen converts a world point to screen coordinates.
+func (c *Camera) ToScreen(p PointF) PointF {
+	return p.Subtract(c.Position).Multiply(c.Zoom).Add(c.Viewport.TopLeft())
+}
+
+// ToWorld converts a screen point to world coordinates.
+func (c *Camera) ToWorld(p PointF) PointF {
+	return p.Subtract(c.Viewport.TopLeft()).Multiply(1 / c.Zoom).Add(c.Position)
+}
+
+// RectToScreen converts a world rectangle to screen coordinates.
+func (c *Camera) RectToScreen(r Rect) Rect {
+	topLeft := c.ToScreen(r.TopLeft())
+	return Rect{X: topLeft.X, Y: topLeft.Y, Width: r.Width * c.Zoom, Height: r.Height * c.Zoom}
+}
+
+// VisibleWorld returns the world rectangle shown in the viewport.
+func (c *Camera) VisibleWorld() Rect {
+	return Rect{X: c.Position.X, Y: c.Position.Y, Width: c.Viewport.Width / c.Zoom, Height: c.Viewport.Height / c.Zoom}
+}
+
+// Pan moves the world by (dx, dy) screen pixels, as if it was dragged.
+func (c *Camera) Pan(dx, dy float64) {
+	c.Position = c.Position.Subtract(PointF{X: dx, Y: dy}.Multiply(1 / c.Zoom))
+	c.clamp()
+}
+
+// ZoomAt multiplies the zoom by factor, keeping the world point under the screen point p in place.
+func (c *Camera) ZoomAt(p PointF, factor float64) {
+	anchor := c.ToWorld(p)
+	c.Zoom *= factor
+	c.clamp()
+	// Move the camera so that the anchor is at p again.
+	c.Position = anchor.Subtract(p.Subtract(c.Viewport.TopLeft()).Multiply(1 / c.Zoom))
+	c.clamp()
+}
+
+// CenterOn moves the camera so that the world point p is at the center of the viewport.
+func (c *Camera) CenterOn(p PointF) {
+	visible := c.VisibleWorld()
+	c.Position = PointF{X: p.X - visible.Width/2, Y: p.Y - visible.Height/2}
+	c.clamp()
+}
+
+// Reveal moves the camera as little as possible so that the world rectangle r is visible.
+func (c *Camera) Reveal(r Rect) {
+	visible := c.VisibleWorld()
+	if r.X < visible.X {
+		c.Position.X = r.X
+	} else if r.X+r.Width > visible.X+visible.Width {
+		c.Position.X = r.X + r.Width - visible.Width
+	}
+	if r.Y < visible.Y {
+		c.Position.Y = r.Y
+	} else if r.Y+r.Height > visible.Y+visible.Height {
+		c.Position.Y = r.Y + r.Height - visible.Height
+	}
+	c.clamp()
+}
+
+// clamp keeps the zoom in its range and the viewport inside the world.
+// An axis on which the world is smaller than the viewport stays at the top left of the world.
+func (c *Camera) clamp() {
+	c.Zoom = max(min(c.Zoom, c.MaxZoom), c.MinZoom())
+	visible := c.VisibleWorld()
+	c.Position.X = clampAxis(c.Position.X, c.World.X, c.World.Width, visible.Width)
+	c.Position.Y = clampAxis(c.Position.Y, c.World.Y, c.World.Height, visible.Height)
+}
+
+func clampAxis(pos, worldPos, worldSize, visibleSize float64) float64 {
+	if worldSize <= visibleSize {
+		return worldPos
+	}
+	return min(max(pos, worldPos), worldPos+worldSize-visibleSize)
+}
@@ -0,0 +1,89 @@
+package geom_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
+)
+
+func newTestCamera() *geom.Camera {
+	viewport := geom.Rect{X: 0, Y: 40, Width: 1040, Height: 560}
+	world := geom.Rect{X: 0, Y: 0, Width: 2000, Height: 1000}
+	return geom.NewCamera(viewport, world, 2)
+}
+
+func TestCamera_Conversion(t *testing.T) {
+	c := newTestCamera()
+	c.Pan(-300, -100)
+
+	p := geom.PointF{X: 500, Y: 200}
+	screen := c.ToScreen(p)
+	if want := (geom.PointF{X: 200, Y: 140}); screen != want {
+		t.Errorf("ToScreen(%v) = %v, want %v", p, screen, want)
+	}
+	if got := c.ToWorld(screen); got != p {
+		t.Errorf("ToWorld(%v) = %v, want %v", screen, got, p)
+	}
+}
+
+func TestCamera_PanStaysInWorld(t *testing.T) {
+	c := newTestCamera()
+
+	c.Pan(100, 100)
+	if want := (geom.PointF{X: 0, Y: 0}); c.Position != want {
+		t.Errorf("Position after panning past the top left = %v, want %v", c.Position, want)
+	}
+
+	c.Pan(-5000, -5000)
+	if want := (geom.PointF{X: 960, Y: 440}); c.Position != want {
+		t.Errorf("Position after panning past the bottom right = %v, want %v", c.Position, want)
+	}
+}
+
+func TestCamera_ZoomAt(t *testing.T) {
+	c := newTestCamera()
+	c.Pan(-500, -200)
+
+	cursor := geom.PointF{X: 520, Y: 320}
+	anchor := c.ToWorld(cursor)
+	c.ZoomAt(cursor, 2)
+
+	if c.Zoom != 2 {
+		t.Errorf("Zoom = %v, want 2", c.Zoom)
+	}
+	if got := c.ToWorld(cursor); got != anchor {
+		t.Errorf("world point under the cursor = %v, want %v", got, anchor)
+	}
+
+	c.ZoomAt(cursor, 0.01)
+	if want := 0.52; c.Zoom != want {
+		t.Errorf("Zoom = %v, want %v to fit the world", c.Zoom, want)
+	}
+}
+
+func TestCamera_SmallWorld(t *testing.T) {
+	viewport := geom.Rect{X: 0, Y: 40, Width: 1040, Height: 560}
+	c := geom.NewCamera(viewport, geom.Rect{X: 0, Y: 0, Width: 500, Height: 300}, 2)
+
+	if c.MinZoom() != 1 {
+		t.Errorf("MinZoom() = %v, want 1", c.MinZoom())
+	}
+	c.Pan(-100, -100)
+	if want := (geom.PointF{X: 0, Y: 0}); c.Position != want {
+		t.Errorf("Position = %v, want %v", c.Position, want)
+	}
+}
+
+func TestCamera_Reveal(t *testing.T) {
+	c := newTestCamera()
+
+	c.Reveal(geom.Rect{X: 1100, Y: 600, Width: 100, Height: 100})
+	if want := (geom.PointF{X: 160, Y: 140}); c.Position != want {
+		t.Errorf("Position = %v, want %v", c.Position, want)
+	}
+
+	c.Reveal(geom.Rect{X: 300, Y: 300, Width: 100, Height: 100})
+	if want := (geom.PointF{X: 160, Y: 140}); c.Position != want {
+		t.Errorf("Position after revealing a visible rect = %v, want %v", c.Position, want)
+	}
+}
@@ -0,0 +1,65 @@
+package geom
+
+// Rect is an axis-aligned rectangle.
+type Rect struct {
+	X, Y, Width, Height float64
+}
+
+// Contains returns true if the pixel (x, y) is inside the rectangle.
+func (r Rect) Contains(x, y int) bool {
+	fx, fy := float64(x), float64(y)
+	return fx >= r.X && fx < r.X+r.Width && fy >= r.Y && fy < r.Y+r.Height
+}
+
+// ContainsRect returns true if other is entirely inside the rectangle.
+func (r Rect) ContainsRect(other Rect) bool {
+	return other.X >= r.X && other.Y >= r.Y &&
+		other.X+other.Width <= r.X+r.Width && other.Y+other.Height <= r.Y+r.Height
+}
+
+func (r Rect) TopLeft() PointF {
+	return PointF{X: r.X, Y: r.Y}
+}
+
+func (r Rect) Center() PointF {
+	return PointF{X: r.X + r.Width/2, Y: r.Y + r.Height/2}
+}
+
+// Intersect returns the overlap of the rectangles. It is the zero Rect if they do not overlap.
+func (r Rect) Intersect(other Rect) Rect {
+	x0, y0 := max(r.X, other.X), max(r.Y, other.Y)
+	x1, y1 := min(r.X+r.Width, other.X+other.Width), min(r.Y+r.Height, other.Y+other.Height)
+	if x1 <= x0 || y1 <= y0 {
+		return Rect{}
+	}
+	return Rect{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+// Translate returns the rectangle moved by (dx, dy).
+func (r Rect) Translate(dx, dy float64) Rect {
+	r.X += dx
+	r.Y += dy
+	return r
+}
+
+// Cell returns the i-th cell of the rectangle divided into cols x rows cells, counted row by row.
+func (r Rect) Cell(cols, rows, i int) Rect {
+	width := r.Width / float64(cols)
+	height := r.Height / float64(rows)
+	return Rect{
+		X:      r.X + float64(i%cols)*width,
+		Y:      r.Y + float64(i/cols)*height,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// Row returns the i-th rectangle of the given size in a row from the top left of the rectangle.
+func (r Rect) Row(i int, width, height, spacing float64) Rect {
+	return Rect{
+		X:      r.X + float64(i)*(width+spacing),
+		Y:      r.Y,
+		Width:  width,
+		Height: height,
+	}
+}
@@ -0,0 +1,79 @@
+package geom_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
+)
+
+func TestRect_Contains(t *testing.T) {
+	r := geom.Rect{X: 960, Y: 40, Width: 80, Height: 80}
+
+	tests := []struct {
+		name string
+		x, y int
+		want bool
+	}{
+		{"top left", 960, 40, true},
+		{"bottom right inside", 1039, 119, true},
+		{"right edge is outside", 1040, 80, false},
+		{"bottom edge is outside", 1000, 120, false},
+		{"left of the rect", 959, 80, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Contains(tt.x, tt.y); got != tt.want {
+				t.Errorf("Contains(%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRect_Cell(t *testing.T) {
+	r := geom.Rect{X: 0, Y: 120, Width: 1040, Height: 480}
+
+	tests := []struct {
+		i    int
+		want geom.Rect
+	}{
+		{0, geom.Rect{X: 0, Y: 120, Width: 520, Height: 160}},
+		{1, geom.Rect{X: 520, Y: 120, Width: 520, Height: 160}},
+		{4, geom.Rect{X: 0, Y: 440, Width: 520, Height: 160}},
+		{5, geom.Rect{X: 520, Y: 440, Width: 520, Height: 160}},
+	}
+
+	for _, tt := range tests {
+		if got := r.Cell(2, 3, tt.i); got != tt.want {
+			t.Errorf("Cell(2, 3, %d) = %v, want %v", tt.i, got, tt.want)
+		}
+	}
+}
+
+func TestRect_Row(t *testing.T) {
+	r := geom.Rect{X: 1044, Y: 72, Width: 235, Height: 24}
+
+	got := r.Row(2, 46, 24, 1)
+	want := geom.Rect{X: 1138, Y: 72, Width: 46, Height: 24}
+	if got != want {
+		t.Errorf("Row(2, 46, 24, 1) = %v, want %v", got, want)
+	}
+	if !r.ContainsRect(r.Row(4, 46, 24, 1)) {
+		t.Errorf("the last filter button is outside %v", r)
+	}
+}
+
+func TestRect_Intersect(t *testing.T) {
+	r := geom.Rect{X: 0, Y: 0, Width: 1000, Height: 500}
+
+	got := r.Intersect(geom.Rect{X: 800, Y: -40, Width: 520, Height: 280})
+	want := geom.Rect{X: 800, Y: 0, Width: 200, Height: 240}
+	if got != want {
+		t.Errorf("Intersect() = %v, want %v", got, want)
+	}
+
+	got = r.Intersect(geom.Rect{X: 1200, Y: 0, Width: 100, Height: 100})
+	if got != (geom.Rect{}) {
+		t.Errorf("Intersect() of separate rects = %v, want the zero Rect", got)
+	}
+}
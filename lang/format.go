@@ -0,0 +1,172 @@
+package lang
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Locale is the formatting rules of a language.
+type Locale struct {
+	Fallbacks        []string            // Fallbacks are the languages searched in order when a key is missing. The default language is always searched last.
+	GroupSeparator   string              // GroupSeparator separates every three digits of the integer part.
+	DecimalSeparator string              // DecimalSeparator separates the integer part and the fraction part.
+	PluralForm       func(n float64) int // PluralForm returns the index of the form used for n in the plural template function.
+	funcs            template.FuncMap
+}
+
+func pluralFormOneOther(n float64) int {
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+func pluralFormSingle(n float64) int {
+	return 0
+}
+
+var defaultLocale = &Locale{
+	GroupSeparator:   ",",
+	DecimalSeparator: ".",
+	PluralForm:       pluralFormOneOther,
+}
+
+var locales = map[string]*Locale{
+	"english": defaultLocale,
+	"japanese": {
+		GroupSeparator:   ",",
+		DecimalSeparator: ".",
+		PluralForm:       pluralFormSingle,
+	},
+}
+
+// SetLocaleForTest registers locale as the formatting rules of lang. Register it before SetTemplatesForTest so that the templates of lang use it.
+// It returns a function that restores the previous Locale.
+func SetLocaleForTest(lang string, locale *Locale) (restore func()) {
+	saved, ok := locales[lang]
+	locales[lang] = locale
+	return func() {
+		if ok {
+			locales[lang] = saved
+		} else {
+			delete(locales, lang)
+		}
+	}
+}
+
+func localeOf(lang string) *Locale {
+	if l, ok := locales[lang]; ok {
+		return l
+	}
+	return defaultLocale
+}
+
+// Number formats an integer with the group separator of the current language.
+func Number(n int) string {
+	return txtProv.locale().Number(n)
+}
+
+// Decimal formats v with digits fraction digits in the current language.
+func Decimal(v float64, digits int) string {
+	return txtProv.locale().Decimal(v, digits)
+}
+
+// Signed formats an integer with its sign in the current language. Zero has no sign.
+func Signed(n int) string {
+	return txtProv.locale().Signed(n)
+}
+
+// Number formats an integer with the group separator.
+func (l *Locale) Number(n int) string {
+	return l.Decimal(float64(n), 0)
+}
+
+// Decimal formats v with digits fraction digits.
+func (l *Locale) Decimal(v float64, digits int) string {
+	s := strconv.FormatFloat(v, 'f', digits, 64)
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	if strings.Trim(intPart+fracPart, "0") == "" {
+		sign = "" // avoid "-0.0" for small negative values
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, r := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(l.GroupSeparator)
+		}
+		b.WriteRune(r)
+	}
+	if hasFrac {
+		b.WriteString(l.DecimalSeparator)
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
+
+// Signed formats an integer with its sign. Zero has no sign.
+func (l *Locale) Signed(n int) string {
+	if n > 0 {
+		return "+" + l.Number(n)
+	}
+	return l.Number(n)
+}
+
+// Plural returns the form for n. The last form is used if the language has fewer forms than its rule expects.
+func (l *Locale) Plural(n any, forms ...string) string {
+	if len(forms) == 0 {
+		return ""
+	}
+	idx := l.PluralForm(toFloat(n))
+	if idx < 0 || idx >= len(forms) {
+		idx = len(forms) - 1
+	}
+	return forms[idx]
+}
+
+// templateFuncs returns the functions available in the templates of the language.
+//
+//	{{number .count}}          1,234
+//	{{decimal .power 1}}       12.5
+//	{{signed .yield}}          +3
+//	{{plural .n "turn" "turns"}}
+func (l *Locale) templateFuncs() template.FuncMap {
+	if l.funcs != nil {
+		return l.funcs
+	}
+	l.funcs = template.FuncMap{
+		"number":  func(n any) string { return l.Decimal(toFloat(n), 0) },
+		"decimal": func(n any, digits int) string { return l.Decimal(toFloat(n), digits) },
+		"signed": func(n any) string {
+			v := toFloat(n)
+			if v > 0 {
+				return "+" + l.Decimal(v, 0)
+			}
+			return l.Decimal(v, 0)
+		},
+		"plural": l.Plural,
+	}
+	return l.funcs
+}
+
+// toFloat converts any integer or floating point value, including named types such as core.Turn, to float64.
+func toFloat(n any) float64 {
+	v := reflect.ValueOf(n)
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	case v.CanFloat():
+		return v.Float()
+	default:
+		return 0
+	}
+}
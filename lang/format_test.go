@@ -0,0 +1,174 @@
+package lang_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+)
+
+// turnForTest is a named integer type like core.Turn.
+type turnForTest int
+
+func pluralOneOther(n float64) int {
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+func pluralSingle(n float64) int {
+	return 0
+}
+
+var (
+	englishLocale  = &lang.Locale{GroupSeparator: ",", DecimalSeparator: ".", PluralForm: pluralOneOther}
+	europeanLocale = &lang.Locale{GroupSeparator: ".", DecimalSeparator: ",", PluralForm: pluralOneOther}
+	japaneseLocale = &lang.Locale{GroupSeparator: ",", DecimalSeparator: ".", PluralForm: pluralSingle}
+)
+
+func TestLocale_Decimal(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale *lang.Locale
+		v      float64
+		digits int
+		want   string
+	}{
+		{"small integer", englishLocale, 999, 0, "999"},
+		{"groups", englishLocale, 1234567, 0, "1,234,567"},
+		{"negative with fraction", englishLocale, -1234.5, 1, "-1,234.5"},
+		{"padded fraction", englishLocale, 0.5, 2, "0.50"},
+		{"no negative zero", englishLocale, -0.04, 1, "0.0"},
+		{"other separators", europeanLocale, 1234.5, 1, "1.234,5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.Decimal(tt.v, tt.digits); got != tt.want {
+				t.Errorf("Decimal(%v, %d) = %q, want %q", tt.v, tt.digits, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocale_Number(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "0"},
+		{100, "100"},
+		{1000, "1,000"},
+		{-1000, "-1,000"},
+		{1234567, "1,234,567"},
+	}
+
+	for _, tt := range tests {
+		if got := englishLocale.Number(tt.n); got != tt.want {
+			t.Errorf("Number(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestLocale_Signed(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{3, "+3"},
+		{0, "0"},
+		{-2, "-2"},
+		{1000, "+1,000"},
+		{-1234, "-1,234"},
+	}
+
+	for _, tt := range tests {
+		if got := englishLocale.Signed(tt.n); got != tt.want {
+			t.Errorf("Signed(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestLocale_Plural(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale *lang.Locale
+		n      any
+		forms  []string
+		want   string
+	}{
+		{"one", englishLocale, 1, []string{"turn", "turns"}, "turn"},
+		{"other", englishLocale, 2, []string{"turn", "turns"}, "turns"},
+		{"zero is other", englishLocale, 0, []string{"turn", "turns"}, "turns"},
+		{"float one", englishLocale, 1.0, []string{"turn", "turns"}, "turn"},
+		{"named type", englishLocale, turnForTest(1), []string{"turn", "turns"}, "turn"},
+		{"single form language", japaneseLocale, 2, []string{"ターン"}, "ターン"},
+		{"fewer forms than the rule", englishLocale, 2, []string{"turn"}, "turn"},
+		{"no forms", englishLocale, 1, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.Plural(tt.n, tt.forms...); got != tt.want {
+				t.Errorf("Plural(%v, %v) = %q, want %q", tt.n, tt.forms, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumber_CurrentLanguage(t *testing.T) {
+	t.Cleanup(lang.SetLocaleForTest("european", europeanLocale))
+	t.Cleanup(lang.SetTemplatesForTest("european", map[string]map[string]string{
+		"english":  {},
+		"european": {},
+	}))
+
+	if got := lang.Number(1234567); got != "1.234.567" {
+		t.Errorf("Number(1234567) = %q, want %q", got, "1.234.567")
+	}
+	if got := lang.Decimal(1234.5, 1); got != "1.234,5" {
+		t.Errorf("Decimal(1234.5, 1) = %q, want %q", got, "1.234,5")
+	}
+	if got := lang.Signed(1000); got != "+1.000" {
+		t.Errorf("Signed(1000) = %q, want %q", got, "+1.000")
+	}
+}
+
+func TestExecuteTemplate_Funcs(t *testing.T) {
+	dicts := map[string]map[string]string{
+		"english": {
+			"number":  "{{number .n}}",
+			"decimal": "{{decimal .p 1}}",
+			"signed":  "{{signed .y}}",
+			"plural":  `{{.n}} {{plural .n "turn" "turns"}}`,
+		},
+		"japanese": {
+			"plural": `{{.n}}{{plural .n "ターン"}}`,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		language string
+		key      string
+		data     map[string]any
+		want     string
+	}{
+		{"number", "english", "number", map[string]any{"n": 12345}, "12,345"},
+		{"number of a named type", "english", "number", map[string]any{"n": turnForTest(1200)}, "1,200"},
+		{"decimal", "english", "decimal", map[string]any{"p": 3.14159}, "3.1"},
+		{"signed positive", "english", "signed", map[string]any{"y": 3}, "+3"},
+		{"signed negative", "english", "signed", map[string]any{"y": -2}, "-2"},
+		{"plural", "english", "plural", map[string]any{"n": 2}, "2 turns"},
+		{"plural of a single form language", "japanese", "plural", map[string]any{"n": 2}, "2ターン"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Cleanup(lang.SetTemplatesForTest(tt.language, dicts))
+			if got := lang.ExecuteTemplate(tt.key, tt.data); got != tt.want {
+				t.Errorf("ExecuteTemplate(%q, %v) = %q, want %q", tt.key, tt.data, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package lang
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
@@ -13,6 +15,34 @@ func Switch() string {
 	return txtProv.Switch()
 }
 
+// Languages returns the names of the available languages, sorted.
+func Languages() []string {
+	return slices.Clone(txtProv.Languages)
+}
+
+// Current returns the name of the current language.
+func Current() string {
+	return txtProv.Languages[txtProv.CurrentLanguageIdx]
+}
+
+// SetLanguage makes name the current language. It returns false if the language is not available.
+func SetLanguage(name string) bool {
+	idx := slices.Index(txtProv.Languages, name)
+	if idx < 0 {
+		return false
+	}
+	txtProv.CurrentLanguageIdx = idx
+	return true
+}
+
+// DisplayName returns the name of the language for display.
+func DisplayName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
 func Text(key string) string {
 	return ExecuteTemplate(key, nil)
 }
@@ -47,13 +77,11 @@ func (p *textProvider) Switch() string {
 	l := len(p.Languages)
 	p.CurrentLanguageIdx = (p.CurrentLanguageIdx + 1) % l
 
-	langName := p.Languages[p.CurrentLanguageIdx]
-	return strings.ToUpper(langName[:1]) + langName[1:]
+	return DisplayName(p.Languages[p.CurrentLanguageIdx])
 }
 
 func (p *textProvider) ExecuteTemplate(key string, data map[string]any) string {
-	lang := p.Languages[p.CurrentLanguageIdx]
-	tmpl, ok := p.Templates[lang][key]
+	tmpl, ok := p.template(key)
 	if !ok {
 		return fmt.Sprintf("NO_TMPL: %s, %v", key, data)
 	}
@@ -65,9 +93,36 @@ func (p *textProvider) ExecuteTemplate(key string, data map[string]any) string {
 	return tmpl.Execute(data)
 }
 
+// template returns the template of key in the current language, or in the first language of the fallback chain that has it.
+func (p *textProvider) template(key string) (*cachedTemplate, bool) {
+	for _, lang := range p.fallbackChain() {
+		if tmpl, ok := p.Templates[lang][key]; ok {
+			return tmpl, true
+		}
+	}
+	return nil, false
+}
+
+// fallbackChain returns the current language, its fallbacks and the default language without duplicates.
+func (p *textProvider) fallbackChain() []string {
+	current := p.Languages[p.CurrentLanguageIdx]
+	chain := []string{current}
+	for _, lang := range append(slices.Clone(localeOf(current).Fallbacks), defaultLanguage) {
+		if !slices.Contains(chain, lang) {
+			chain = append(chain, lang)
+		}
+	}
+	return chain
+}
+
+func (p *textProvider) locale() *Locale {
+	return localeOf(p.Languages[p.CurrentLanguageIdx])
+}
+
 type cachedTemplate struct {
-	Text string
-	tmpl *template.Template
+	Text  string
+	funcs template.FuncMap
+	tmpl  *template.Template
 }
 
 func (c *cachedTemplate) Execute(data map[string]any) string {
@@ -87,7 +142,7 @@ func (c *cachedTemplate) template() *template.Template {
 		return c.tmpl
 	}
 
-	t, err := template.New("t").Parse(c.Text)
+	t, err := template.New("t").Funcs(c.funcs).Parse(c.Text)
 	if err != nil {
 		t = template.Must(template.New("fallback").Delims("[[", "]]").Parse("ERR:" + c.Text))
 	}
@@ -95,8 +150,8 @@ func (c *cachedTemplate) template() *template.Template {
 	return t
 }
 
-func init() {
-	langData := asset.LoadTemplates()
+// newTextProvider creates a textProvider with the templates in langData, with the default language as the current language.
+func newTextProvider(langData map[string]map[string]string) *textProvider {
 	langs := make([]string, 0, len(langData))
 	langTmpls := make(map[string]map[string]*cachedTemplate, len(langData))
 
@@ -104,7 +159,7 @@ func init() {
 		langs = append(langs, lang)
 		tmpls := make(map[string]*cachedTemplate, len(dict))
 		for key, tmplTxt := range dict {
-			tmpls[key] = &cachedTemplate{Text: tmplTxt}
+			tmpls[key] = &cachedTemplate{Text: tmplTxt, funcs: localeOf(lang).templateFuncs()}
 		}
 
 		langTmpls[lang] = tmpls
@@ -112,10 +167,25 @@ func init() {
 
 	sort.Strings(langs)
 
-	txtProv = &textProvider{
+	p := &textProvider{
 		Languages: langs,
 		Templates: langTmpls,
 	}
+	p.SetDefault()
+	return p
+}
+
+func init() {
+	txtProv = newTextProvider(asset.LoadTemplates())
+}
 
-	txtProv.SetDefault()
+// SetTemplatesForTest replaces the languages and their templates with dicts and makes current the current language.
+// It returns a function that restores the previous templates and Locales.
+func SetTemplatesForTest(current string, dicts map[string]map[string]string) (restore func()) {
+	savedProv, savedLocales := txtProv, maps.Clone(locales)
+	txtProv = newTextProvider(dicts)
+	SetLanguage(current)
+	return func() {
+		txtProv, locales = savedProv, savedLocales
+	}
 }
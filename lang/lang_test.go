@@ -0,0 +1,73 @@
+package lang_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+)
+
+func TestExecuteTemplate_FallbackChain(t *testing.T) {
+	t.Cleanup(lang.SetLocaleForTest("pirate", &lang.Locale{Fallbacks: []string{"japanese"}, PluralForm: pluralOneOther}))
+	t.Cleanup(lang.SetLocaleForTest("robot", &lang.Locale{Fallbacks: []string{"english", "robot"}, PluralForm: pluralOneOther}))
+
+	dicts := map[string]map[string]string{
+		"english":  {"a": "A-en", "b": "B-en", "c": "C-en"},
+		"japanese": {"a": "A-ja", "b": "B-ja"},
+		"pirate":   {"a": "A-pi"},
+		"robot":    {"a": "A-ro"},
+	}
+
+	tests := []struct {
+		current string
+		key     string
+		want    string
+	}{
+		{"pirate", "a", "A-pi"},
+		{"pirate", "b", "B-ja"},
+		{"pirate", "c", "C-en"},
+		{"japanese", "c", "C-en"},
+		{"english", "a", "A-en"},
+		{"robot", "b", "B-en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+"/"+tt.key, func(t *testing.T) {
+			t.Cleanup(lang.SetTemplatesForTest(tt.current, dicts))
+			if got := lang.Text(tt.key); got != tt.want {
+				t.Errorf("Text(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteTemplate_MissingKey(t *testing.T) {
+	t.Cleanup(lang.SetTemplatesForTest("japanese", map[string]map[string]string{
+		"english":  {"a": "A-en"},
+		"japanese": {"a": "A-ja"},
+	}))
+
+	if got := lang.ExecuteTemplate("missing", nil); !strings.HasPrefix(got, "NO_TMPL") {
+		t.Errorf("ExecuteTemplate(missing) = %q, want NO_TMPL", got)
+	}
+}
+
+func TestSetLanguage(t *testing.T) {
+	t.Cleanup(lang.SetTemplatesForTest("english", map[string]map[string]string{
+		"english":  {"a": "A-en"},
+		"japanese": {"a": "A-ja"},
+	}))
+
+	if !lang.SetLanguage("japanese") {
+		t.Fatalf("SetLanguage(japanese) = false, want true")
+	}
+	if lang.Current() != "japanese" || lang.Text("a") != "A-ja" {
+		t.Errorf("Current(), Text(a) = %s, %s, want japanese, A-ja", lang.Current(), lang.Text("a"))
+	}
+	if lang.SetLanguage("klingon") {
+		t.Errorf("SetLanguage(klingon) = true, want false")
+	}
+	if lang.Current() != "japanese" {
+		t.Errorf("Current() = %s after an unknown language, want japanese", lang.Current())
+	}
+}
@@ -0,0 +1,122 @@
+package langaudit
+
+import (
+	"sort"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/load"
+)
+
+// ContentKeys returns every lang key the content created by the load package will request, sorted.
+// The keys of all the difficulties are included because the content is built per difficulty.
+func ContentKeys() []string {
+	c := keyCollector{}
+
+	for _, achievement := range load.LoadAchievements() {
+		c.add(achievement.NameKey, achievement.DescriptionKey)
+	}
+
+	for _, difficulty := range load.Difficulties() {
+		c.add(string(difficulty.ID))
+		c.addGameState(load.LoadGameState(difficulty))
+	}
+
+	return c.keys()
+}
+
+type keyCollector map[string]struct{}
+
+func (c keyCollector) add(keys ...string) {
+	for _, key := range keys {
+		if key != "" {
+			c[key] = struct{}{}
+		}
+	}
+}
+
+func (c keyCollector) keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c keyCollector) addGameState(gameState *core.GameState) {
+	c.add(string(gameState.MyNation.ID()))
+
+	for _, condition := range gameState.DefeatConditions {
+		c.add(condition.ReasonKey())
+	}
+
+	for _, cardID := range gameState.CardDisplayOrder {
+		c.addCard(gameState.CardDictionary, cardID)
+	}
+
+	for nationID, market := range gameState.Markets {
+		c.add(string(nationID))
+		for _, item := range market.Items {
+			cardPack := item.CardPack()
+			c.add(string(cardPack.CardPackID))
+			for cardID := range cardPack.Ratios {
+				c.addCard(gameState.CardDictionary, cardID)
+			}
+		}
+	}
+
+	for _, point := range gameState.MapGrid.Points {
+		switch p := point.(type) {
+		case *core.OtherNationPoint:
+			c.add(string(p.Nation().ID()))
+		case *core.WildernessPoint:
+			c.addEnemy(p.Enemy())
+			territory := p.Territory()
+			if territory == nil {
+				continue
+			}
+			c.addTerrain(territory.Terrain())
+			if upgrade, ok := territory.TerrainUpgrade(); ok {
+				c.addTerrain(upgrade.To)
+			}
+		case *core.BossPoint:
+			c.add("point-boss")
+			for _, phase := range p.Phases() {
+				c.addEnemy(phase.Enemy)
+				c.add(phase.DialogueKey)
+			}
+		}
+	}
+}
+
+func (c keyCollector) addCard(dictionary *core.CardDictionary, cardID core.CardID) {
+	if battleCard, ok := dictionary.BattleCard(cardID); ok {
+		c.add(string(battleCard.ID()), string(battleCard.Type))
+		if battleCard.Skill != nil {
+			c.add(string(battleCard.Skill.BattleCardSkillID), battleCard.Skill.DescriptionKey)
+		}
+	}
+	if structureCard, ok := dictionary.StructureCard(cardID); ok {
+		c.add(string(structureCard.ID()), structureCard.DescriptionKey())
+	}
+}
+
+func (c keyCollector) addEnemy(enemy *core.Enemy) {
+	if enemy == nil {
+		return
+	}
+	c.add(string(enemy.ID()), string(enemy.Type()), enemy.TalkKey())
+	for _, skill := range enemy.Skills() {
+		c.add(string(skill.ID()), skill.DescriptionKey())
+	}
+}
+
+func (c keyCollector) addTerrain(terrain *core.Terrain) {
+	if terrain == nil {
+		return
+	}
+	c.add(string(terrain.ID()))
+	for _, effect := range terrain.BattleEffects() {
+		c.add(effect.DescriptionKey)
+	}
+}
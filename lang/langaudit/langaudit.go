@@ -0,0 +1,242 @@
+// Package langaudit checks that the lang CSVs under asset/lang contain every key the game requests.
+// It reads the files from disk instead of the embedded assets so that it runs without a graphics environment.
+package langaudit
+
+import (
+	"encoding/csv"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Report is the result of an audit.
+type Report struct {
+	Languages    []string            // Languages are the names of the CSV files without the extension, sorted.
+	Missing      map[string][]string // Missing is the content keys absent from each language.
+	Untranslated map[string][]string // Untranslated is the keys that another language has but each language does not.
+	Unused       []string            // Unused is the keys that are neither content keys nor string literals in the source.
+}
+
+// OK returns true if no key is missing or untranslated. Unused keys are not treated as errors.
+func (r *Report) OK() bool {
+	for _, keys := range r.Missing {
+		if len(keys) > 0 {
+			return false
+		}
+	}
+	for _, keys := range r.Untranslated {
+		if len(keys) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteTo writes the report in a human readable form.
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	for _, language := range r.Languages {
+		for _, key := range r.Missing[language] {
+			fmt.Fprintf(&b, "missing\t%s\t%s\n", language, key)
+		}
+	}
+	for _, language := range r.Languages {
+		for _, key := range r.Untranslated[language] {
+			fmt.Fprintf(&b, "untranslated\t%s\t%s\n", language, key)
+		}
+	}
+	for _, key := range r.Unused {
+		fmt.Fprintf(&b, "unused\t%s\n", key)
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// Audit checks the CSVs in langDir against ContentKeys and the string literals in the Go files under sourceDir.
+func Audit(langDir, sourceDir string) (*Report, error) {
+	dicts, err := LoadLanguages(langDir)
+	if err != nil {
+		return nil, err
+	}
+	literals, err := SourceLiterals(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Missing:      make(map[string][]string),
+		Untranslated: make(map[string][]string),
+	}
+	allKeys := make(map[string]struct{})
+	for language, dict := range dicts {
+		report.Languages = append(report.Languages, language)
+		for key := range dict {
+			allKeys[key] = struct{}{}
+		}
+	}
+	sort.Strings(report.Languages)
+
+	contentKeys := ContentKeys()
+	used := make(map[string]struct{}, len(contentKeys))
+	for _, key := range contentKeys {
+		used[key] = struct{}{}
+	}
+
+	for _, language := range report.Languages {
+		dict := dicts[language]
+		for _, key := range contentKeys {
+			if _, ok := dict[key]; !ok {
+				report.Missing[language] = append(report.Missing[language], key)
+			}
+		}
+		for key := range allKeys {
+			if _, ok := dict[key]; !ok {
+				report.Untranslated[language] = append(report.Untranslated[language], key)
+			}
+		}
+		sort.Strings(report.Untranslated[language])
+	}
+
+	for key := range allKeys {
+		_, isContent := used[key]
+		_, isLiteral := literals[key]
+		if !isContent && !isLiteral {
+			report.Unused = append(report.Unused, key)
+		}
+	}
+	sort.Strings(report.Unused)
+
+	return report, nil
+}
+
+// LoadLanguages reads every CSV in dir the same way as the asset package and returns the keys by language.
+func LoadLanguages(dir string) (map[string]map[string]struct{}, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.csv"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no CSV in %s", dir)
+	}
+
+	dicts := make(map[string]map[string]struct{}, len(paths))
+	for _, p := range paths {
+		dict, err := loadCSVKeys(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		dicts[strings.TrimSuffix(filepath.Base(p), ".csv")] = dict
+	}
+	return dicts, nil
+}
+
+func loadCSVKeys(path string) (map[string]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+	lines, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{}, len(lines))
+	for i, line := range lines {
+		if len(line) != 2 {
+			return nil, fmt.Errorf("line %d length should 2 but %d, data: %v", i, len(line), line)
+		}
+		if _, ok := keys[line[0]]; ok {
+			return nil, fmt.Errorf("line %d: duplicated key %s", i, line[0])
+		}
+		keys[line[0]] = struct{}{}
+	}
+	return keys, nil
+}
+
+// SourceLiterals returns the string literals in the Go files under dir.
+// A lang key written as a literal is regarded as used.
+func SourceLiterals(dir string) (map[string]struct{}, error) {
+	literals := make(map[string]struct{})
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			if s, err := strconv.Unquote(lit.Value); err == nil {
+				literals[s] = struct{}{}
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return literals, nil
+}
+
+// TB is the part of testing.TB that Check uses. It keeps the testing package out of the cmd tool.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Logf(format string, args ...any)
+}
+
+// Check audits the CSVs in langDir and reports missing and untranslated keys as test errors.
+// Unused keys are only logged because some keys are built at runtime.
+func Check(t TB, langDir, sourceDir string) {
+	t.Helper()
+
+	report, err := Audit(langDir, sourceDir)
+	if err != nil {
+		t.Fatalf("Audit() error = %v", err)
+	}
+
+	for _, language := range report.Languages {
+		for _, key := range report.Missing[language] {
+			t.Errorf("%s: missing key %s", language, key)
+		}
+		for _, key := range report.Untranslated[language] {
+			t.Errorf("%s: key %s exists in another language", language, key)
+		}
+	}
+	for _, key := range report.Unused {
+		t.Logf("unused key %s", key)
+	}
+}
@@ -0,0 +1,11 @@
+package langaudit_test
+
+import (
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/lang/langaudit"
+)
+
+func TestLangCSVs(t *testing.T) {
+	langaudit.Check(t, "../../asset/lang", "../..")
+}
@@ -0,0 +1,175 @@
+package lang
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+)
+
+// Keys starting with packMetaPrefix configure the Locale of a translation pack instead of giving a template.
+//
+//	_fallbacks          languages searched when a key is missing, separated by spaces
+//	_group-separator    see Locale.GroupSeparator
+//	_decimal-separator  see Locale.DecimalSeparator
+//	_plural             "one-other" (English style) or "single" (Japanese style)
+const packMetaPrefix = "_"
+
+// PackReport is the result of loading a translation pack.
+type PackReport struct {
+	Path     string
+	Language string
+	New      bool     // New is true if the pack adds a language instead of overriding an embedded one.
+	Loaded   int      // Loaded is the number of templates taken from the pack.
+	Missing  int      // Missing is the number of base keys the pack does not have. They fall back to other languages.
+	Unknown  []string // Unknown is the keys that are not in the base key set. They are ignored.
+	Invalid  []string // Invalid is the keys whose template cannot be parsed. They are ignored.
+	Err      error    // Err is set when nothing could be loaded from the pack.
+}
+
+func (r PackReport) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("translation pack %s: %v", r.Path, r.Err)
+	}
+	return fmt.Sprintf("translation pack %s: language %s, %d loaded, %d missing, %d unknown, %d invalid",
+		r.Path, r.Language, r.Loaded, r.Missing, len(r.Unknown), len(r.Invalid))
+}
+
+// LoadPacks loads every CSV in the root of fsys as a translation pack.
+// A pack named after an embedded language overrides its templates, and any other name adds a language to the rotation of Switch.
+// The keys are validated against the keys of the default language.
+func LoadPacks(fsys fs.FS) []PackReport {
+	return txtProv.LoadPacks(fsys)
+}
+
+func (p *textProvider) LoadPacks(fsys fs.FS) []PackReport {
+	paths, err := fs.Glob(fsys, "*.csv")
+	if err != nil {
+		return []PackReport{{Path: ".", Err: err}}
+	}
+	sort.Strings(paths)
+
+	reports := make([]PackReport, 0, len(paths))
+	for _, name := range paths {
+		reports = append(reports, p.loadPack(fsys, name))
+	}
+	return reports
+}
+
+func (p *textProvider) loadPack(fsys fs.FS, filePath string) PackReport {
+	lang := strings.TrimSuffix(path.Base(filePath), ".csv")
+	report := PackReport{Path: filePath, Language: lang}
+
+	f, err := fsys.Open(filePath)
+	if err != nil {
+		report.Err = err
+		return report
+	}
+	defer f.Close()
+
+	dict, err := asset.ParseLangCSV(f)
+	if err != nil {
+		report.Err = err
+		return report
+	}
+
+	locale, err := packLocale(lang, dict)
+	if err != nil {
+		report.Err = err
+		return report
+	}
+
+	base := p.Templates[defaultLanguage]
+	tmpls := make(map[string]*cachedTemplate, len(dict))
+	for key, text := range dict {
+		if strings.HasPrefix(key, packMetaPrefix) {
+			continue
+		}
+		if _, ok := base[key]; !ok {
+			report.Unknown = append(report.Unknown, key)
+			continue
+		}
+		if _, err := template.New("t").Funcs(locale.templateFuncs()).Parse(text); err != nil {
+			report.Invalid = append(report.Invalid, key)
+			continue
+		}
+		tmpls[key] = &cachedTemplate{Text: text}
+	}
+	sort.Strings(report.Unknown)
+	sort.Strings(report.Invalid)
+
+	if len(tmpls) == 0 {
+		report.Err = errors.New("no valid template")
+		return report
+	}
+
+	for key := range base {
+		if _, ok := tmpls[key]; !ok {
+			report.Missing++
+		}
+	}
+	report.Loaded = len(tmpls)
+	report.New = p.Templates[lang] == nil
+
+	p.addTemplates(lang, locale, tmpls)
+	return report
+}
+
+// addTemplates merges tmpls into the templates of lang with locale, adding lang to Languages if it is new.
+func (p *textProvider) addTemplates(lang string, locale *Locale, tmpls map[string]*cachedTemplate) {
+	locales[lang] = locale
+
+	existing, ok := p.Templates[lang]
+	if !ok {
+		existing = make(map[string]*cachedTemplate, len(tmpls))
+		p.Templates[lang] = existing
+
+		current := p.Languages[p.CurrentLanguageIdx]
+		p.Languages = append(p.Languages, lang)
+		sort.Strings(p.Languages)
+		p.CurrentLanguageIdx = slices.Index(p.Languages, current)
+	}
+	for key, tmpl := range tmpls {
+		existing[key] = tmpl
+	}
+
+	// The locale may have changed, so the templates are parsed again with its functions.
+	for _, tmpl := range existing {
+		tmpl.funcs = locale.templateFuncs()
+		tmpl.tmpl = nil
+	}
+}
+
+// packLocale returns the Locale of lang configured by the meta keys of the pack.
+func packLocale(lang string, dict map[string]string) (*Locale, error) {
+	locale := *localeOf(lang)
+	locale.funcs = nil
+
+	if v, ok := dict[packMetaPrefix+"fallbacks"]; ok {
+		locale.Fallbacks = strings.Fields(v)
+	}
+	if v, ok := dict[packMetaPrefix+"group-separator"]; ok {
+		locale.GroupSeparator = v
+	}
+	if v, ok := dict[packMetaPrefix+"decimal-separator"]; ok {
+		locale.DecimalSeparator = v
+	}
+	if v, ok := dict[packMetaPrefix+"plural"]; ok {
+		switch v {
+		case "one-other":
+			locale.PluralForm = pluralFormOneOther
+		case "single":
+			locale.PluralForm = pluralFormSingle
+		default:
+			return nil, fmt.Errorf("unknown plural rule %q", v)
+		}
+	}
+
+	return &locale, nil
+}
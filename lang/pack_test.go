@@ -0,0 +1,111 @@
+package lang_test
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+)
+
+func TestLoadPacks(t *testing.T) {
+	t.Cleanup(lang.SetTemplatesForTest("english", map[string]map[string]string{
+		"english":  {"greeting": "Hello", "turns": "{{.n}} turns", "title": "Title", "coins": `{{plural .n "coin" "coins"}}`},
+		"japanese": {"greeting": "こんにちは", "turns": "{{.n}}ターン", "title": "タイトル", "coins": "コイン"},
+	}))
+	fsys := fstest.MapFS{
+		"pirate.csv": {Data: []byte(
+			"_fallbacks, \"japanese\"\n" +
+				"_group-separator, \"_\"\n" +
+				"_plural, \"single\"\n" +
+				"greeting, \"Ahoy\"\n" +
+				"coins, \"{{plural .n \"doubloon\" \"doubloons\"}}\"\n" +
+				"unknown-key, \"Arr\"\n" +
+				"turns, \"{{.n\"\n",
+		)},
+		"english.csv":     {Data: []byte("greeting, \"Hi\"\n")},
+		"empty.csv":       {Data: []byte("unknown-key, \"Arr\"\n")},
+		"bad-plural.csv":  {Data: []byte("_plural, \"dual\"\ngreeting, \"Hi\"\n")},
+		"broken.csv":      {Data: []byte("greeting, \"Hi\", \"extra\"\n")},
+		"readme.txt":      {Data: []byte("not a pack")},
+		"nested/deep.csv": {Data: []byte("greeting, \"Deep\"\n")},
+	}
+
+	reports := lang.LoadPacks(fsys)
+
+	paths := make([]string, 0, len(reports))
+	for _, r := range reports {
+		paths = append(paths, r.Path)
+	}
+	wantPaths := []string{"bad-plural.csv", "broken.csv", "empty.csv", "english.csv", "pirate.csv"}
+	if !slices.Equal(paths, wantPaths) {
+		t.Fatalf("paths = %v, want %v", paths, wantPaths)
+	}
+
+	t.Run("invalid packs are reported", func(t *testing.T) {
+		for _, r := range reports[:3] {
+			if r.Err == nil {
+				t.Errorf("%s: Err = nil, want an error", r.Path)
+			}
+		}
+	})
+
+	t.Run("override of an embedded language", func(t *testing.T) {
+		r := reports[3]
+		if r.Err != nil || r.New || r.Loaded != 1 || r.Missing != 3 {
+			t.Errorf("report = %+v, want 1 loaded and 3 missing for an existing language", r)
+		}
+		if got := lang.Text("greeting"); got != "Hi" {
+			t.Errorf("greeting = %q, want %q", got, "Hi")
+		}
+		if got := lang.Text("title"); got != "Title" {
+			t.Errorf("title = %q, want the embedded template", got)
+		}
+	})
+
+	t.Run("new language", func(t *testing.T) {
+		r := reports[4]
+		if r.Err != nil || !r.New || r.Language != "pirate" {
+			t.Fatalf("report = %+v, want a new language pirate", r)
+		}
+		if r.Loaded != 2 || r.Missing != 2 {
+			t.Errorf("Loaded, Missing = %d, %d, want 2, 2", r.Loaded, r.Missing)
+		}
+		if !slices.Equal(r.Unknown, []string{"unknown-key"}) {
+			t.Errorf("Unknown = %v, want [unknown-key]", r.Unknown)
+		}
+		if !slices.Equal(r.Invalid, []string{"turns"}) {
+			t.Errorf("Invalid = %v, want [turns]", r.Invalid)
+		}
+	})
+
+	t.Run("the language joins the rotation", func(t *testing.T) {
+		if got := lang.Switch(); got != "Japanese" {
+			t.Fatalf("Switch() = %q, want %q", got, "Japanese")
+		}
+		if got := lang.Switch(); got != "Pirate" {
+			t.Fatalf("Switch() = %q, want %q", got, "Pirate")
+		}
+		t.Cleanup(func() {
+			if got := lang.Switch(); got != "English" {
+				t.Errorf("Switch() = %q, want the rotation to go back to English", got)
+			}
+		})
+
+		if got := lang.Text("greeting"); got != "Ahoy" {
+			t.Errorf("greeting = %q, want %q", got, "Ahoy")
+		}
+		if got := lang.Text("title"); got != "タイトル" {
+			t.Errorf("title = %q, want the fallback to japanese", got)
+		}
+		if got := lang.ExecuteTemplate("turns", map[string]any{"n": 3}); got != "3ターン" {
+			t.Errorf("turns = %q, want the fallback to japanese for an invalid template", got)
+		}
+		if got := lang.Number(1234567); got != "1_234_567" {
+			t.Errorf("Number(1234567) = %q, want %q", got, "1_234_567")
+		}
+		if got := lang.ExecuteTemplate("coins", map[string]any{"n": 2}); got != "doubloon" {
+			t.Errorf("coins = %q, want the single plural form", got)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package load
+
+import (
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+// LoadAchievements creates the achievement definitions.
+// The conditions may count events, so create new definitions for each run.
+func LoadAchievements() []*core.Achievement {
+	return []*core.Achievement{
+		newAchievement("achievement-first-battle", &core.AchievementConditionEventCount{Type: core.EventTypeBattleWon, Count: 1}),
+		newAchievement("achievement-demon-slayer", &core.AchievementConditionEventCount{Type: core.EventTypeVictory, Count: 1}),
+		newAchievement("achievement-early-victory", &core.AchievementConditionVictoryBefore{Turn: 12}), // Before Year 2
+		newAchievement("achievement-knights-dragon", &core.AchievementConditionBattleWon{
+			EnemyID: "enemy-dragon",
+			CardIDs: []core.CardID{"battlecard-knight"},
+		}),
+		newAchievement("achievement-full-territory", &core.AchievementConditionTerritoryFilled{}),
+		newAchievement("achievement-collector", &core.AchievementConditionEventCount{Type: core.EventTypePackOpened, Count: 10}),
+	}
+}
+
+// newAchievement creates an Achievement whose lang keys are derived from the ID.
+func newAchievement(id core.AchievementID, condition core.AchievementCondition) *core.Achievement {
+	return &core.Achievement{
+		ID:             id,
+		NameKey:        string(id),
+		DescriptionKey: string(id) + "-desc",
+		Condition:      condition,
+	}
+}
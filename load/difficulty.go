@@ -0,0 +1,34 @@
+package load
+
+import (
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+// Difficulty IDs. They are also the lang keys of the difficulty names.
+const (
+	DifficultyEasy      core.DifficultyID = "difficulty-easy"
+	DifficultyNormal    core.DifficultyID = "difficulty-normal"
+	DifficultyHard      core.DifficultyID = "difficulty-hard"
+	DifficultyNightmare core.DifficultyID = "difficulty-nightmare"
+)
+
+// Difficulties returns the difficulty presets from the easiest to the hardest.
+func Difficulties() []*core.Difficulty {
+	return []*core.Difficulty{
+		{ID: DifficultyEasy, EnemyPowerMultiplier: 0.75, YieldMultiplier: 1.5, PriceMultiplier: 0.75, TreasuryBonus: core.ResourceQuantity{Money: 8, Food: 8}, ScoreMultiplier: 0.5},
+		{ID: DifficultyNormal, EnemyPowerMultiplier: 1.0, YieldMultiplier: 1.0, PriceMultiplier: 1.0, ScoreMultiplier: 1.0},
+		{ID: DifficultyHard, EnemyPowerMultiplier: 1.25, YieldMultiplier: 1.0, PriceMultiplier: 1.25, ScoreMultiplier: 1.5},
+		{ID: DifficultyNightmare, EnemyPowerMultiplier: 1.5, YieldMultiplier: 0.75, PriceMultiplier: 1.5, ScoreMultiplier: 2.0},
+	}
+}
+
+// DifficultyByID returns the difficulty preset with the given ID, or Normal if it is not found.
+func DifficultyByID(id core.DifficultyID) *core.Difficulty {
+	difficulties := Difficulties()
+	for _, d := range difficulties {
+		if d.ID == id {
+			return d
+		}
+	}
+	return difficulties[1]
+}
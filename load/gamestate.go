@@ -1,18 +1,24 @@
 package load
 
 import (
+	"time"
+
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
 )
 
-// LoadGameState generates the initial game state (dummy data)
-func LoadGameState() *core.GameState {
+// LoadGameState generates the initial game state (dummy data).
+// The multipliers of difficulty are applied to enemies, yields and prices, and its bonus is added to the starting treasury.
+func LoadGameState(difficulty *core.Difficulty) *core.GameState {
 	myNation := createMyNation()
-	treasury := createTreasury()
+	treasury := createTreasury(difficulty)
 	cardDictionary, cardDisplayOrder := createCardDictionary()
 	cardDeck := createCardDeck()
 	cardPacks, cardPackPrices := createCardPacksAndPrices()
+	for id, price := range cardPackPrices {
+		cardPackPrices[id] = price.Scale(difficulty.PriceMultiplier)
+	}
 	markets := createMarkets(cardPacks, cardPackPrices)
-	mapGrid := createMapGrid(myNation, cardPacks, cardPackPrices)
+	mapGrid := createMapGrid(myNation, cardPacks, cardPackPrices, difficulty)
 
 	gs := &core.GameState{
 		MyNation:         myNation,
@@ -23,17 +29,42 @@ func LoadGameState() *core.GameState {
 		CardDictionary:   cardDictionary,
 		Markets:          markets,
 		CardDisplayOrder: cardDisplayOrder,
+		DefeatConditions: createDefeatConditions(),
+		CapitalDefense:   capitalDefense,
+		Upkeep:           upkeep,
+		Seed:             time.Now().UnixNano(),
+		Difficulty:       difficulty,
 	}
 
 	return gs
 }
 
+// createDefeatConditions creates the conditions under which the player loses.
+// The Demon Lord fully awakens after 5 years.
+func createDefeatConditions() []core.DefeatCondition {
+	return []core.DefeatCondition{
+		&core.DefeatConditionTurnLimit{Limit: 60},
+		&core.DefeatConditionCapitalLost{},
+		&core.DefeatConditionBankruptcy{MinMoney: -debtLimit},
+	}
+}
+
+// debtLimit is the amount of Money the treasury can owe before the kingdom goes bankrupt.
+const debtLimit = 20
+
+// upkeep is the Money paid each turn for every StructureCard placed in a Territory.
+var upkeep = core.ResourceQuantity{Money: 1}
+
+// capitalDefense is the number of lost boss battles the capital withstands. It falls at the next one.
+const capitalDefense = 2
+
 func createMyNation() *core.MyNation {
 	return core.NewMyNation("nation-mynation", "My Nation")
 }
 
-func createTreasury() *core.Treasury {
-	return &core.Treasury{}
+// createTreasury creates the starting Treasury. It is empty unless the difficulty adds a bonus.
+func createTreasury(difficulty *core.Difficulty) *core.Treasury {
+	return &core.Treasury{Resources: difficulty.TreasuryBonus}
 }
 
 func createCardDeck() *core.CardDeck {
@@ -89,9 +120,10 @@ func createCardPacksAndPrices() (map[string]*core.CardPack, map[string]core.Reso
 			CardPackID: "cardpack-war",
 			NumPerOpen: 5,
 			Ratios: map[core.CardID]int{
-				"structurecard-catapult": 2,
-				"structurecard-ballista": 1,
-				"structurecard-camp":     1,
+				"structurecard-catapult":   2,
+				"structurecard-ballista":   1,
+				"structurecard-camp":       1,
+				"structurecard-watchtower": 1,
 			},
 		},
 		"cardpack-magic": {
@@ -175,12 +207,13 @@ func createCardPacksAndPrices() (map[string]*core.CardPack, map[string]core.Reso
 			CardPackID: "cardpack-building",
 			NumPerOpen: 2,
 			Ratios: map[core.CardID]int{
-				"structurecard-granary": 1,
-				"structurecard-sawmill": 1,
-				"structurecard-smelter": 1,
-				"structurecard-mint":    1,
-				"structurecard-temple":  1,
-				"structurecard-camp":    1,
+				"structurecard-granary":  1,
+				"structurecard-sawmill":  1,
+				"structurecard-smelter":  1,
+				"structurecard-mint":     1,
+				"structurecard-temple":   1,
+				"structurecard-camp":     1,
+				"structurecard-hospital": 1,
 			},
 		},
 		"cardpack-forest": {
@@ -237,7 +270,7 @@ func createCardPacksAndPrices() (map[string]*core.CardPack, map[string]core.Reso
 	return cardPacks, cardPackPrices
 }
 
-func createMapGrid(myNation *core.MyNation, cardPacks map[string]*core.CardPack, cardPackPrices map[string]core.ResourceQuantity) *core.MapGrid {
+func createMapGrid(myNation *core.MyNation, cardPacks map[string]*core.CardPack, cardPackPrices map[string]core.ResourceQuantity, difficulty *core.Difficulty) *core.MapGrid {
 	size := core.MapGridSize{X: 5, Y: 5}
 	points := make([]core.Point, size.Length())
 
@@ -283,41 +316,44 @@ func createMapGrid(myNation *core.MyNation, cardPacks map[string]*core.CardPack,
 		terrainType string
 		baseYield   core.ResourceQuantity
 	}{
-		{1, 0, "enemy-goblin", "enemy-type-demonic", 3, 3, []*core.EnemySkill{}, "terrain-forest", core.ResourceQuantity{Wood: 2}},
+		{1, 0, "enemy-goblin", "enemy-type-demonic", 3, 3, []*core.EnemySkill{createSwarmSkill()}, "terrain-forest", core.ResourceQuantity{Wood: 2}},
 		{0, 1, "enemy-sabrelouse", "enemy-type-animal", 4, 3, []*core.EnemySkill{}, "terrain-mountain", core.ResourceQuantity{Iron: 2}},
-		{1, 1, "enemy-rattlesnake", "enemy-type-dragon", 6, 3, []*core.EnemySkill{}, "terrain-plain", core.ResourceQuantity{Food: 2}},
+		{1, 1, "enemy-rattlesnake", "enemy-type-dragon", 6, 3, []*core.EnemySkill{createAntiMagicSkill()}, "terrain-plain", core.ResourceQuantity{Food: 2}},
 		{2, 1, "enemy-condor", "enemy-type-flying", 6, 3, []*core.EnemySkill{createEvasionSkill()}, "terrain-desert", core.ResourceQuantity{}},
 		{1, 2, "enemy-slime", "enemy-type-unknown", 6, 3, []*core.EnemySkill{createSoftSkill()}, "terrain-desert", core.ResourceQuantity{}},
-		{0, 3, "enemy-crocodile", "enemy-type-dragon", 10, 4, []*core.EnemySkill{}, "terrain-mountain", core.ResourceQuantity{Iron: 2}},
-		{3, 0, "enemy-grizzly", "enemy-type-animal", 12, 4, []*core.EnemySkill{}, "terrain-forest", core.ResourceQuantity{Wood: 2}},
+		{0, 3, "enemy-crocodile", "enemy-type-dragon", 10, 4, []*core.EnemySkill{createAmbushSkill()}, "terrain-mountain", core.ResourceQuantity{Iron: 2}},
+		{3, 0, "enemy-grizzly", "enemy-type-animal", 12, 4, []*core.EnemySkill{createRoarSkill()}, "terrain-forest", core.ResourceQuantity{Wood: 2}},
 		{1, 3, "enemy-skeleton", "enemy-type-undead", 12, 4, []*core.EnemySkill{createLongbowSkill()}, "terrain-mana-node", core.ResourceQuantity{Mana: 3}},
 		{3, 1, "enemy-elemental", "enemy-type-unknown", 20, 5, []*core.EnemySkill{createIncorporealitySkill()}, "terrain-mountain", core.ResourceQuantity{Iron: 2}},
 		{1, 4, "enemy-dragon", "enemy-type-dragon", 30, 6, []*core.EnemySkill{createPressureSkill()}, "terrain-plain", core.ResourceQuantity{Food: 2}},
 		{4, 1, "enemy-griffin", "enemy-type-flying", 25, 5, []*core.EnemySkill{createEvasionSkill()}, "terrain-plain", core.ResourceQuantity{Food: 2}},
 		{2, 3, "enemy-vampire", "enemy-type-undead", 30, 7, []*core.EnemySkill{createCharmSkill()}, "terrain-forest", core.ResourceQuantity{Wood: 2}},
-		{3, 2, "enemy-living-armor", "enemy-type-unknown", 50, 7, []*core.EnemySkill{}, "terrain-mana-node", core.ResourceQuantity{Mana: 3}},
+		{3, 2, "enemy-living-armor", "enemy-type-unknown", 50, 7, []*core.EnemySkill{createMirrorSkill()}, "terrain-mana-node", core.ResourceQuantity{Mana: 3}},
 		{3, 4, "enemy-arc-demon", "enemy-type-demonic", 40, 8, []*core.EnemySkill{createMagicBarrierSkill()}, "terrain-forest", core.ResourceQuantity{Wood: 2}},
 		{4, 3, "enemy-durendal", "enemy-type-undead", 40, 8, []*core.EnemySkill{createSideAttackSkill()}, "terrain-mountain", core.ResourceQuantity{Iron: 2}},
 		{3, 3, "enemy-obelisk", "enemy-type-unknown", 40, 8, []*core.EnemySkill{createLaserSkill()}, "terrain-mana-node", core.ResourceQuantity{Mana: 3}},
 	}
 
+	development := createTerritoryDevelopment(difficulty)
 	for _, config := range wildernessConfigs {
 		enemy := core.NewEnemy(
 			core.EnemyID(config.enemyID),
 			core.EnemyType(config.enemyType),
-			config.power,
+			config.power*difficulty.EnemyPowerMultiplier,
 			config.skills,
 			config.cardSlot,
 		)
 
-		terrain := core.NewTerrain(
+		terrain := core.NewTerrainWithBattleEffects(
 			core.TerrainID(config.terrainType),
-			config.baseYield,
-			3, // Set all to 3
+			config.baseYield.Scale(difficulty.YieldMultiplier),
+			baseCardSlot,
+			createTerrainBattleEffects(config.terrainType),
 		)
-		territory := core.NewTerritory(
+		territory := core.NewTerritoryWithDevelopment(
 			core.TerritoryID("territory-"+config.enemyID),
 			terrain,
+			development,
 		)
 
 		wilderness := &core.WildernessPoint{}
@@ -329,21 +365,27 @@ func createMapGrid(myNation *core.MyNation, cardPacks map[string]*core.CardPack,
 	}
 
 	// Boss Point (4,4)
-	boss := core.NewEnemy(
-		"enemy-final-boss",
-		"enemy-type-demonic",
-		60,
-		[]*core.EnemySkill{createWaveSkill()},
-		9,
-	)
-	bossPoint := &core.BossPoint{}
-	bossPoint.SetBossForTest(boss)
-	bossPoint.SetDefeatedForTest(false)
+	// The final boss is fought in three phases. The cards used in a phase are not returned until the boss is beaten.
+	bossPoint := core.NewBossPoint([]*core.BossPhase{
+		{
+			Enemy:       core.NewEnemy("enemy-final-boss", "enemy-type-demonic", 30*difficulty.EnemyPowerMultiplier, []*core.EnemySkill{createPressureSkill()}, 7),
+			DialogueKey: "enemy-final-boss-phase-1",
+		},
+		{
+			Enemy:       core.NewEnemy("enemy-final-boss", "enemy-type-demonic", 40*difficulty.EnemyPowerMultiplier, []*core.EnemySkill{createAmbushSkill(), createEruptionSkill()}, 8),
+			DialogueKey: "enemy-final-boss-phase-2",
+		},
+		{
+			Enemy:       core.NewEnemy("enemy-final-boss", "enemy-type-demonic", 50*difficulty.EnemyPowerMultiplier, []*core.EnemySkill{createWaveSkill()}, 9),
+			DialogueKey: "enemy-final-boss-phase-3",
+		},
+	})
 	points[size.Index(4, 4)] = bossPoint
 
 	mapGrid := &core.MapGrid{
-		Size:   size,
-		Points: points,
+		Size:         size,
+		Points:       points,
+		SynergyRules: createSynergyRules(),
 	}
 	mapGrid.UpdateAccesibles()
 
@@ -569,6 +611,7 @@ func createBattleCards() []*core.BattleCard {
 						},
 					},
 				},
+				SurvivalRateBonus: 0.2,
 			},
 			Type: "cardtype-mag",
 		},
@@ -642,6 +685,7 @@ func createBattleCards() []*core.BattleCard {
 						},
 					},
 				},
+				Timing: core.BattleCardSkillTimingFirstRound,
 			},
 			Type: "cardtype-str",
 		},
@@ -658,6 +702,7 @@ func createBattleCards() []*core.BattleCard {
 						},
 					},
 				},
+				SurvivalRateBonus: 0.3,
 			},
 			Type: "cardtype-agi",
 		},
@@ -724,6 +769,7 @@ func createBattleCards() []*core.BattleCard {
 						},
 					},
 				},
+				SurvivalRateBonus: 0.2,
 			},
 			Type: "cardtype-agi",
 		},
@@ -768,87 +814,152 @@ func createBattleCards() []*core.BattleCard {
 func createStructureCards() []*core.StructureCard {
 	cards := []*core.StructureCard{
 		// Yield Additive系
-		core.NewStructureCard(
-			"structurecard-farm",
-			core.ResourceQuantity{Food: 2},
-			core.NewResourceModifier(),
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-woodcutter",
-			core.ResourceQuantity{Wood: 2},
-			core.NewResourceModifier(),
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-tunnel",
-			core.ResourceQuantity{Iron: 2},
-			core.NewResourceModifier(),
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-market",
-			core.ResourceQuantity{Money: 5},
-			core.NewResourceModifier(),
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-shrine",
-			core.ResourceQuantity{Mana: 2},
-			core.NewResourceModifier(),
-			0.0, 0),
+		core.NewStructureCardWithEffects("structurecard-farm",
+			&core.StructureEffectYieldAdditive{Yield: core.ResourceQuantity{Food: 2}}),
+		core.NewStructureCardWithEffects("structurecard-woodcutter",
+			&core.StructureEffectYieldAdditive{Yield: core.ResourceQuantity{Wood: 2}}),
+		core.NewStructureCardWithEffects("structurecard-tunnel",
+			&core.StructureEffectYieldAdditive{Yield: core.ResourceQuantity{Iron: 2}}),
+		core.NewStructureCardWithEffects("structurecard-market",
+			&core.StructureEffectYieldAdditive{Yield: core.ResourceQuantity{Money: 5}}),
+		core.NewStructureCardWithEffects("structurecard-shrine",
+			&core.StructureEffectYieldAdditive{Yield: core.ResourceQuantity{Mana: 2}},
+			// A random magic card every 5 turns
+			&core.StructureEffectGrantCard{Interval: 5, CardIDs: []core.CardID{"battlecard-wizard", "battlecard-mage", "battlecard-fortune"}}),
 
 		// Yield Multiplicative系
-		core.NewStructureCard(
-			"structurecard-granary",
-			core.ResourceQuantity{},
-			core.ResourceModifier{Food: 0.5},
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-sawmill",
-			core.ResourceQuantity{},
-			core.ResourceModifier{Wood: 0.5},
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-smelter",
-			core.ResourceQuantity{},
-			core.ResourceModifier{Iron: 0.5},
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-mint",
-			core.ResourceQuantity{},
-			core.ResourceModifier{Money: 0.5},
-			0.0, 0),
-		core.NewStructureCard(
-			"structurecard-temple",
-			core.ResourceQuantity{},
-			core.ResourceModifier{Mana: 0.5},
-			0.0, 0),
+		core.NewStructureCardWithEffects("structurecard-granary",
+			&core.StructureEffectYieldModifier{Modifier: core.ResourceModifier{Food: 0.5}}),
+		core.NewStructureCardWithEffects("structurecard-sawmill",
+			&core.StructureEffectYieldModifier{Modifier: core.ResourceModifier{Wood: 0.5}}),
+		core.NewStructureCardWithEffects("structurecard-smelter",
+			&core.StructureEffectYieldModifier{Modifier: core.ResourceModifier{Iron: 0.5}}),
+		core.NewStructureCardWithEffects("structurecard-mint",
+			&core.StructureEffectYieldModifier{Modifier: core.ResourceModifier{Money: 0.5}}),
+		core.NewStructureCardWithEffects("structurecard-temple",
+			&core.StructureEffectYieldModifier{Modifier: core.ResourceModifier{Mana: 0.5}}),
 
 		// Support CardSlot系
-		core.NewStructureCard(
-			"structurecard-camp",
-			core.ResourceQuantity{},
-			core.NewResourceModifier(),
-			0.0, 1),
+		core.NewStructureCardWithEffects("structurecard-camp",
+			&core.StructureEffectSupport{CardSlot: 1}),
 
 		// Support Power系
-		core.NewStructureCard(
-			"structurecard-catapult",
-			core.ResourceQuantity{},
-			core.NewResourceModifier(),
-			3.0, 0),
-		core.NewStructureCard(
-			"structurecard-ballista",
-			core.ResourceQuantity{},
-			core.NewResourceModifier(),
-			5.0, 0),
-		core.NewStructureCard(
-			"structurecard-orban-cannon",
-			core.ResourceQuantity{},
-			core.NewResourceModifier(),
-			8.0, 0),
+		// Siege weapons reach farther, losing half of the power for each step.
+		core.NewStructureCardWithEffects("structurecard-catapult",
+			&core.StructureEffectSupport{Power: 3.0, Range: 1}),
+		core.NewStructureCardWithEffects("structurecard-ballista",
+			&core.StructureEffectSupport{Power: 5.0, Range: 2, Falloff: 0.5}),
+		core.NewStructureCardWithEffects("structurecard-orban-cannon",
+			&core.StructureEffectSupport{Power: 8.0, Range: 3, Falloff: 0.5}),
+
+		// Triggered系
+		core.NewStructureCardWithEffects("structurecard-watchtower",
+			&core.StructureEffectReveal{Range: 2}),
+		core.NewStructureCardWithEffects("structurecard-hospital",
+			&core.StructureEffectHeal{Count: 1}),
 	}
 
 	return cards
 }
 
+// baseCardSlot is the number of card slots of a Territory at level 1. Levels add more.
+const baseCardSlot = 3
+
+// createTerritoryDevelopment creates the levels of Territories and the upgrades of terrains.
+func createTerritoryDevelopment(difficulty *core.Difficulty) *core.TerritoryDevelopment {
+	return &core.TerritoryDevelopment{
+		Levels: []*core.TerritoryLevel{
+			// Level 2
+			{
+				Cost:          core.ResourceQuantity{Money: 10, Wood: 5}.Scale(difficulty.PriceMultiplier),
+				HeldTurns:     12,
+				CardSlotBonus: 1,
+				YieldModifier: core.ResourceModifier{Money: 0.5, Food: 0.5, Wood: 0.5, Iron: 0.5, Mana: 0.5},
+			},
+			// Level 3
+			{
+				Cost:          core.ResourceQuantity{Money: 20, Wood: 10, Iron: 5}.Scale(difficulty.PriceMultiplier),
+				HeldTurns:     30,
+				CardSlotBonus: 2,
+				YieldModifier: core.ResourceModifier{Money: 1.0, Food: 1.0, Wood: 1.0, Iron: 1.0, Mana: 1.0},
+			},
+		},
+		Upgrades: []*core.TerrainUpgrade{
+			{
+				From:     "terrain-plain",
+				To:       core.NewTerrain("terrain-farmland", core.ResourceQuantity{Food: 4}.Scale(difficulty.YieldMultiplier), baseCardSlot),
+				Cost:     core.ResourceQuantity{Money: 8, Wood: 4}.Scale(difficulty.PriceMultiplier),
+				MinLevel: 2,
+			},
+			{
+				From:     "terrain-desert",
+				To:       core.NewTerrain("terrain-oasis", core.ResourceQuantity{Money: 2, Food: 2}.Scale(difficulty.YieldMultiplier), baseCardSlot),
+				Cost:     core.ResourceQuantity{Money: 8, Mana: 4}.Scale(difficulty.PriceMultiplier),
+				MinLevel: 2,
+			},
+		},
+	}
+}
+
+// createSynergyRules creates the yield synergies between neighbouring territories.
+func createSynergyRules() []core.SynergyRule {
+	return []core.SynergyRule{
+		// Woodcutter: Wood +1 per adjacent territory with a sawmill
+		&core.SynergyRuleNeighbourCard{
+			CardID:          "structurecard-woodcutter",
+			NeighbourCardID: "structurecard-sawmill",
+			Yield:           core.ResourceQuantity{Wood: 1},
+		},
+		// Market: Money +2 when adjacent to a nation
+		&core.SynergyRuleNeighbourPoint{
+			CardID:     "structurecard-market",
+			PointTypes: []core.PointType{core.PointTypeMyNation, core.PointTypeOtherNation},
+			Yield:      core.ResourceQuantity{Money: 2},
+		},
+		// Temple: Mana +1 per adjacent territory with a temple
+		&core.SynergyRuleNeighbourCard{
+			CardID:          "structurecard-temple",
+			NeighbourCardID: "structurecard-temple",
+			Yield:           core.ResourceQuantity{Mana: 1},
+		},
+	}
+}
+
+// createTerrainBattleEffects creates the effects of a terrain on the battles fought on it.
+func createTerrainBattleEffects(terrainType string) []*core.TerrainBattleEffect {
+	switch terrainType {
+	case "terrain-forest":
+		// Agility type card power +1
+		return []*core.TerrainBattleEffect{{
+			DescriptionKey: "terrain-effect-forest",
+			CardType:       "cardtype-agi",
+			Modifier:       &core.BattleCardPowerModifier{AdditiveBuff: 1.0},
+		}}
+	case "terrain-mana-node":
+		// Magic type card power +50%
+		return []*core.TerrainBattleEffect{{
+			DescriptionKey: "terrain-effect-mana-node",
+			CardType:       "cardtype-mag",
+			Modifier:       &core.BattleCardPowerModifier{MultiplicativeBuff: 0.5},
+		}}
+	case "terrain-mountain":
+		// Card slot -1
+		return []*core.TerrainBattleEffect{{
+			DescriptionKey: "terrain-effect-mountain",
+			CardSlotDelta:  -1,
+		}}
+	case "terrain-desert":
+		// Strength type card power -1
+		return []*core.TerrainBattleEffect{{
+			DescriptionKey: "terrain-effect-desert",
+			CardType:       "cardtype-str",
+			Modifier:       &core.BattleCardPowerModifier{AdditiveDebuff: 1.0},
+		}}
+	default:
+		return nil
+	}
+}
+
 // Helper functions for generating enemy skills
 
 func createEvasionSkill() *core.EnemySkill {
@@ -984,3 +1095,62 @@ func createWaveSkill() *core.EnemySkill {
 		},
 	)
 }
+
+func createEruptionSkill() *core.EnemySkill {
+	// All card power -3 every other round
+	return core.NewIntervalEnemySkill(
+		"enemy-skill-eruption",
+		2,
+		func(idx int, options *core.EnemySkillCalculationOptions) bool {
+			return true
+		},
+		&core.BattleCardPowerModifier{
+			AdditiveDebuff: 3.0,
+		},
+	)
+}
+
+func createSwarmSkill() *core.EnemySkill {
+	// Enemy power +1 per card played
+	return core.NewEnemySkillWithCalculator(
+		"enemy-skill-swarm",
+		1,
+		&core.EnemySkillCalculatorPowerPerCard{PowerPerCard: 1.0},
+	)
+}
+
+func createRoarSkill() *core.EnemySkill {
+	// Card slot -1
+	return core.NewEnemySkillWithCalculator(
+		"enemy-skill-roar",
+		1,
+		&core.EnemySkillCalculatorCardSlot{Delta: -1},
+	)
+}
+
+func createAmbushSkill() *core.EnemySkill {
+	// Support power is ignored
+	return core.NewEnemySkillWithCalculator(
+		"enemy-skill-ambush",
+		1,
+		&core.EnemySkillCalculatorNegateSupportPower{},
+	)
+}
+
+func createAntiMagicSkill() *core.EnemySkill {
+	// Magic type cards cannot be placed
+	return core.NewEnemySkillWithCalculator(
+		"enemy-skill-anti-magic",
+		1,
+		&core.EnemySkillCalculatorForbidCardType{CardType: "cardtype-mag"},
+	)
+}
+
+func createMirrorSkill() *core.EnemySkill {
+	// Enemy power + the base power of the strongest card
+	return core.NewEnemySkillWithCalculator(
+		"enemy-skill-mirror",
+		1,
+		&core.EnemySkillCalculatorCopyStrongest{Ratio: 1.0},
+	)
+}
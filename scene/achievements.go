@@ -0,0 +1,112 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/bamenn"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/load"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+	"github.com/noppikinatta/ebitenginegamejam2025/ui"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
+)
+
+// Achievements is the scene showing the achievements and the unlocks saved in the local profile.
+type Achievements struct {
+	viewModel  *viewmodel.AchievementViewModel
+	input      *ui.Input
+	canInput   bool
+	nextScene  ebiten.Game
+	sequence   *bamenn.Sequence
+	transition bamenn.Transition
+}
+
+func NewAchievements(input *ui.Input) *Achievements {
+	return &Achievements{
+		input: input,
+	}
+}
+
+func (a *Achievements) Init(nextScene ebiten.Game, sequence *bamenn.Sequence, transition bamenn.Transition) {
+	a.nextScene = nextScene
+	a.sequence = sequence
+	a.transition = transition
+}
+
+// OnStart reloads the profile, so the unlocks of the latest runs are shown.
+func (a *Achievements) OnStart() {
+	a.canInput = false
+	sound.PlayBGM(asset.BGMTitle)
+	// An unavailable profile is shown as an empty one.
+	profile, _ := store.LoadProfile()
+	a.viewModel = viewmodel.NewAchievementViewModel(load.LoadAchievements(), profile)
+}
+
+func (a *Achievements) OnArrival() {
+	a.canInput = true
+}
+
+func (a *Achievements) Update() error {
+	if !a.canInput {
+		return nil
+	}
+
+	if a.input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) || isDismissed(a.input) {
+		a.canInput = false
+		a.sequence.SwitchWithTransition(a.nextScene, a.transition)
+	}
+
+	return nil
+}
+
+func (a *Achievements) Draw(screen *ebiten.Image) {
+	// Background color
+	screen.Fill(color.RGBA{20, 20, 40, 255})
+
+	if a.viewModel == nil {
+		return
+	}
+
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 40)
+	drawing.DrawText(screen, a.viewModel.Title(), 48, opt)
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(640, 56)
+	drawing.DrawText(screen, a.viewModel.ProgressText(), 24, opt)
+
+	for i := range a.viewModel.NumAchievements() {
+		y := 130 + float64(i)*72
+
+		// Unlocked achievements are highlighted.
+		if a.viewModel.IsUnlocked(i) {
+			drawing.DrawRect(screen, 32, y-4, 1216, 64, 0.3, 0.3, 0.1, 1.0)
+		} else {
+			drawing.DrawRect(screen, 32, y-4, 1216, 64, 0.15, 0.15, 0.2, 1.0)
+		}
+
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(40, y)
+		drawing.DrawText(screen, a.viewModel.Name(i), 24, opt)
+
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(40, y+30)
+		drawing.DrawText(screen, a.viewModel.Description(i), 18, opt)
+
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(1040, y)
+		drawing.DrawText(screen, a.viewModel.UnlockedDateText(i), 20, opt)
+	}
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(440, 660)
+	drawing.DrawText(screen, lang.Text("achievements-back"), 28, opt)
+}
+
+func (a *Achievements) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return 1280, 720
+}
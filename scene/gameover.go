@@ -0,0 +1,110 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/bamenn"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
+	"github.com/noppikinatta/ebitenginegamejam2025/ui"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
+)
+
+// GameOver is the scene shown when the player loses the game.
+type GameOver struct {
+	viewModel        *viewmodel.GameOverViewModel
+	historyViewModel *viewmodel.HistoryViewModel
+	historyExporter  historyExporter
+	input            *ui.Input
+	canInput         bool
+	nextScene        ebiten.Game
+	sequence         *bamenn.Sequence
+	transition       bamenn.Transition
+}
+
+func NewGameOver(input *ui.Input) *GameOver {
+	return &GameOver{
+		input: input,
+	}
+}
+
+func (g *GameOver) Init(nextScene ebiten.Game, sequence *bamenn.Sequence, transition bamenn.Transition) {
+	g.nextScene = nextScene
+	g.sequence = sequence
+	g.transition = transition
+}
+
+// SetGameState sets the GameState of the finished game.
+func (g *GameOver) SetGameState(gameState *core.GameState) {
+	g.viewModel = viewmodel.NewGameOverViewModel(gameState)
+	g.historyViewModel = viewmodel.NewHistoryViewModel(gameState)
+	g.historyExporter.SetGameState(gameState)
+}
+
+func (g *GameOver) OnStart() {
+	g.canInput = false
+	sound.PlayBGM(asset.BGMDefeat)
+}
+
+func (g *GameOver) OnArrival() {
+	g.canInput = true
+}
+
+func (g *GameOver) Update() error {
+	if !g.canInput {
+		return nil
+	}
+
+	if isDismissed(g.input) {
+		g.canInput = false
+		g.sequence.SwitchWithTransition(g.nextScene, g.transition)
+		return nil
+	}
+
+	if g.input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) {
+		x, y := g.input.Mouse.CursorPosition()
+		if g.historyExporter.HandleClick(x, y) {
+			return nil
+		}
+		g.canInput = false
+		g.sequence.SwitchWithTransition(g.nextScene, g.transition)
+	}
+
+	return nil
+}
+
+func (g *GameOver) Draw(screen *ebiten.Image) {
+	// Background color
+	screen.Fill(color.RGBA{40, 10, 10, 255})
+
+	if g.viewModel == nil {
+		return
+	}
+
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 40)
+	drawing.DrawText(screen, g.viewModel.Title(), 48, opt)
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 120)
+	drawing.DrawText(screen, g.viewModel.TurnText(), 24, opt)
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 160)
+	drawing.DrawText(screen, g.viewModel.ReasonText(), 24, opt)
+
+	drawHistories(screen, g.historyViewModel, 40)
+	g.historyExporter.Draw(screen)
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(440, 640)
+	drawing.DrawText(screen, lang.Text("defeat-back-to-title"), 28, opt)
+}
+
+func (g *GameOver) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return 1280, 720
+}
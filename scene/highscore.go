@@ -0,0 +1,97 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/bamenn"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+	"github.com/noppikinatta/ebitenginegamejam2025/ui"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
+)
+
+// HighScores is the scene showing the local high score table.
+type HighScores struct {
+	viewModel  *viewmodel.HighScoreViewModel
+	input      *ui.Input
+	canInput   bool
+	nextScene  ebiten.Game
+	sequence   *bamenn.Sequence
+	transition bamenn.Transition
+}
+
+func NewHighScores(input *ui.Input) *HighScores {
+	return &HighScores{
+		input: input,
+	}
+}
+
+func (h *HighScores) Init(nextScene ebiten.Game, sequence *bamenn.Sequence, transition bamenn.Transition) {
+	h.nextScene = nextScene
+	h.sequence = sequence
+	h.transition = transition
+}
+
+// OnStart reloads the table, so the scores of the latest runs are shown.
+func (h *HighScores) OnStart() {
+	h.canInput = false
+	sound.PlayBGM(asset.BGMTitle)
+	// An unavailable table is shown as an empty one.
+	table, _ := store.LoadHighScores()
+	h.viewModel = viewmodel.NewHighScoreViewModel(table)
+}
+
+func (h *HighScores) OnArrival() {
+	h.canInput = true
+}
+
+func (h *HighScores) Update() error {
+	if !h.canInput {
+		return nil
+	}
+
+	if h.input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) || isDismissed(h.input) {
+		h.canInput = false
+		h.sequence.SwitchWithTransition(h.nextScene, h.transition)
+	}
+
+	return nil
+}
+
+func (h *HighScores) Draw(screen *ebiten.Image) {
+	// Background color
+	screen.Fill(color.RGBA{20, 20, 40, 255})
+
+	if h.viewModel == nil {
+		return
+	}
+
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 40)
+	drawing.DrawText(screen, h.viewModel.Title(), 48, opt)
+
+	numEntries := h.viewModel.NumEntries()
+	if numEntries == 0 {
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(40, 140)
+		drawing.DrawText(screen, h.viewModel.EmptyText(), 24, opt)
+	}
+
+	for i := range numEntries {
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(40, 140+float64(i)*44)
+		drawing.DrawText(screen, h.viewModel.EntryText(i), 24, opt)
+	}
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(440, 640)
+	drawing.DrawText(screen, lang.Text("highscore-back"), 28, opt)
+}
+
+func (h *HighScores) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return 1280, 720
+}
@@ -0,0 +1,45 @@
+package scene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
+)
+
+// maxDrawnHistories is the number of the latest events drawn on the end-of-game screens.
+const maxDrawnHistories = 10
+
+// drawHistories draws the latest events of the run with the left edge at x.
+func drawHistories(screen *ebiten.Image, vm *viewmodel.HistoryViewModel, x float64) {
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(x, 240)
+	drawing.DrawText(screen, lang.Text("ui-history"), 24, opt)
+
+	historyLen := vm.HistoryLen()
+	if historyLen == 0 {
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(x, 280)
+		drawing.DrawText(screen, lang.Text("ui-no-events"), 18, opt)
+		return
+	}
+
+	historyDelta := 0
+	if historyLen > maxDrawnHistories {
+		historyDelta = historyLen - maxDrawnHistories
+		historyLen = maxDrawnHistories
+	}
+
+	for i := range historyLen {
+		historyIdx := i + historyDelta
+		y := 280.0 + float64(i)*32
+
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(x, y)
+		drawing.DrawText(screen, vm.HistoryDateText(historyIdx), 18, opt)
+
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(x+200, y)
+		drawing.DrawText(screen, vm.HistoryEventText(historyIdx), 18, opt)
+	}
+}
@@ -0,0 +1,66 @@
+package scene
+
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+)
+
+// historyExporter draws the buttons to export the history log of a finished game
+// at (40,640,160,36) for JSON and (220,640,160,36) for CSV, and shows the result below them.
+type historyExporter struct {
+	gameState *core.GameState
+	message   string
+}
+
+func (e *historyExporter) SetGameState(gameState *core.GameState) {
+	e.gameState = gameState
+	e.message = ""
+}
+
+// HandleClick exports the history log if a button is clicked. It returns true if the click is handled.
+func (e *historyExporter) HandleClick(x, y int) bool {
+	if e.gameState == nil || y < 640 || y >= 676 {
+		return false
+	}
+
+	var format store.HistoryFormat
+	switch {
+	case x >= 40 && x < 200:
+		format = store.HistoryFormatJSON
+	case x >= 220 && x < 380:
+		format = store.HistoryFormatCSV
+	default:
+		return false
+	}
+
+	p, err := store.ExportHistories(e.gameState.Histories, format, time.Now())
+	if err != nil {
+		e.message = lang.Text("history-export-failed")
+	} else {
+		e.message = lang.ExecuteTemplate("history-exported", map[string]any{"path": p})
+	}
+	return true
+}
+
+func (e *historyExporter) Draw(screen *ebiten.Image) {
+	drawing.DrawRect(screen, 40, 640, 160, 36, 0.3, 0.3, 0.4, 1.0)
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(52, 648)
+	drawing.DrawText(screen, lang.Text("history-export-json"), 18, opt)
+
+	drawing.DrawRect(screen, 220, 640, 160, 36, 0.3, 0.3, 0.4, 1.0)
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(232, 648)
+	drawing.DrawText(screen, lang.Text("history-export-csv"), 18, opt)
+
+	if e.message != "" {
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(40, 686)
+		drawing.DrawText(screen, e.message, 14, opt)
+	}
+}
@@ -1,34 +1,45 @@
 package scene
 
 import (
+	"time"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/bamenn"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
 	"github.com/noppikinatta/ebitenginegamejam2025/load"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
 	"github.com/noppikinatta/ebitenginegamejam2025/ui"
 )
 
+// gameStateReceiver is a scene that shows the finished GameState.
+type gameStateReceiver interface {
+	SetGameState(gameState *core.GameState)
+}
+
+// difficultyReceiver is a scene that starts a game on the selected Difficulty.
+type difficultyReceiver interface {
+	SetDifficulty(difficulty *core.Difficulty)
+}
+
 type InGame struct {
+	difficulty *core.Difficulty
 	gameState  *core.GameState
 	gameUI     *ui.GameUI
 	input      *ui.Input
 	canInput   bool
 	nextScene  ebiten.Game
+	gameOver   *GameOver
 	sequence   *bamenn.Sequence
 	transition bamenn.Transition
 }
 
+// NewInGame creates the in-game scene. The game itself is created in OnStart.
 func NewInGame(input *ui.Input) *InGame {
-	// Create a dummy GameState (simplified implementation for Game Jam)
-	gameState := load.LoadGameState()
-
-	// Initialize GameUI
-	gameUI := ui.NewGameUI(gameState)
-
 	return &InGame{
-		gameState: gameState,
-		gameUI:    gameUI,
-		input:     input,
+		difficulty: load.DifficultyByID(load.DifficultyNormal),
+		input:      input,
 	}
 }
 
@@ -38,6 +49,49 @@ func (g *InGame) Init(nextScene ebiten.Game, sequence *bamenn.Sequence, transiti
 	g.transition = transition
 }
 
+// SetDifficulty sets the Difficulty of the next game.
+func (g *InGame) SetDifficulty(difficulty *core.Difficulty) {
+	g.difficulty = difficulty
+}
+
+// InitGameOver sets the scene shown when the player loses the game.
+func (g *InGame) InitGameOver(gameOver *GameOver) {
+	g.gameOver = gameOver
+}
+
+// OnStart starts a new game, so a replay does not reuse the finished GameState.
+func (g *InGame) OnStart() {
+	g.canInput = false
+	sound.PlayBGM(asset.BGM)
+	g.startGame()
+}
+
+// startGame creates a new GameState and GameUI, and starts tracking achievements.
+func (g *InGame) startGame() {
+	g.gameState = load.LoadGameState(g.difficulty)
+	g.gameUI = ui.NewGameUI(g.gameState)
+	g.subscribeAchievements()
+	g.gameState.Subscribe(sound.HandleEvent)
+}
+
+// subscribeAchievements tracks the achievements of the game and saves new unlocks to the local profile.
+func (g *InGame) subscribeAchievements() {
+	// The profile is not available on some platforms (e.g. browsers). Achievements are tracked only in memory then.
+	profile, _ := store.LoadProfile()
+
+	unlocked := make([]core.AchievementID, 0, len(profile.Achievements))
+	for id := range profile.Achievements {
+		unlocked = append(unlocked, core.AchievementID(id))
+	}
+
+	tracker := core.NewAchievementTracker(load.LoadAchievements(), unlocked, func(achievement *core.Achievement) {
+		if profile.UnlockAchievement(string(achievement.ID), time.Now()) {
+			_ = store.SaveProfile(profile)
+		}
+	})
+	g.gameState.Subscribe(tracker.HandleEvent)
+}
+
 func (g *InGame) OnArrival() {
 	g.canInput = true
 }
@@ -48,10 +102,23 @@ func (g *InGame) Update() error {
 	}
 
 	if g.gameState.IsVictory() {
+		g.canInput = false
+		g.recordHighScore()
+		if receiver, ok := g.nextScene.(gameStateReceiver); ok {
+			receiver.SetGameState(g.gameState)
+		}
 		g.sequence.SwitchWithTransition(g.nextScene, g.transition)
 		return nil
 	}
 
+	if g.gameState.IsDefeat() && g.gameOver != nil {
+		g.canInput = false
+		g.recordHighScore()
+		g.gameOver.SetGameState(g.gameState)
+		g.sequence.SwitchWithTransition(g.gameOver, g.transition)
+		return nil
+	}
+
 	// Handle input for GameUI (mouse position is handled automatically inside HandleInput)
 	if err := g.gameUI.HandleInput(g.input); err != nil {
 		return err
@@ -65,6 +132,12 @@ func (g *InGame) Update() error {
 	return nil
 }
 
+// recordHighScore saves the score of the finished game to the local high score table.
+func (g *InGame) recordHighScore() {
+	// The high score table is not available on some platforms (e.g. browsers). The game goes on without it.
+	_, _ = store.RecordHighScore(g.gameState)
+}
+
 func (g *InGame) Draw(screen *ebiten.Image) {
 	// All drawing is done in GameUI
 	g.gameUI.Draw(screen)
@@ -1,16 +1,16 @@
 package scene
 
 import (
-	"fmt"
-
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/geom"
 	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
 )
 
 type langSwitcher struct {
+	settings    *store.Settings
 	alpha       float32
 	keys        []ebiten.Key
 	currentLang string
@@ -22,6 +22,9 @@ func (s *langSwitcher) Update() {
 	for _, k := range s.keys {
 		if k == ebiten.KeyL {
 			s.currentLang = lang.Switch()
+			s.settings.Language = lang.Current()
+			// The language stays switched for this launch even if it cannot be saved.
+			_ = store.SaveSettings(s.settings)
 			s.alpha = 1
 			break
 		}
@@ -75,5 +78,5 @@ func (s *langSwitcher) Draw(screen *ebiten.Image) {
 	topt := ebiten.DrawImageOptions{}
 	topt.GeoM.Translate(0, 8)
 	topt.ColorScale.Scale(s.alpha, s.alpha, s.alpha, s.alpha)
-	drawing.DrawText(screen, fmt.Sprintf("Current Language: %s", s.currentLang), 16, &topt)
+	drawing.DrawText(screen, lang.ExecuteTemplate("ui-current-language", map[string]any{"language": s.currentLang}), 16, &topt)
 }
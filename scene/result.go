@@ -5,32 +5,28 @@ import (
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/bamenn"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
 	"github.com/noppikinatta/ebitenginegamejam2025/ui"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
 )
 
-type GameHistory struct {
-	entries []string
-}
-
 type Result struct {
-	history    *GameHistory
-	input      *ui.Input
-	nextScene  ebiten.Game
-	sequence   *bamenn.Sequence
-	transition bamenn.Transition
+	viewModel        *viewmodel.ResultViewModel
+	historyViewModel *viewmodel.HistoryViewModel
+	historyExporter  historyExporter
+	input            *ui.Input
+	canInput         bool
+	nextScene        ebiten.Game
+	sequence         *bamenn.Sequence
+	transition       bamenn.Transition
 }
 
 func NewResult(input *ui.Input) *Result {
 	return &Result{
-		history: &GameHistory{
-			entries: []string{
-				"Kingdom Year 1000, Month 4: Game Started",
-				"Kingdom Year 1000, Month 5: First Alliance Formed",
-				"Kingdom Year 1000, Month 8: Boss Defeated",
-			},
-		},
 		input: input,
 	}
 }
@@ -41,7 +37,41 @@ func (r *Result) Init(nextScene ebiten.Game, sequence *bamenn.Sequence, transiti
 	r.transition = transition
 }
 
+// SetGameState sets the GameState of the finished game.
+func (r *Result) SetGameState(gameState *core.GameState) {
+	r.viewModel = viewmodel.NewResultViewModel(gameState)
+	r.historyViewModel = viewmodel.NewHistoryViewModel(gameState)
+	r.historyExporter.SetGameState(gameState)
+}
+
+func (r *Result) OnStart() {
+	r.canInput = false
+	sound.PlayBGM(asset.BGMVictory)
+}
+
+func (r *Result) OnArrival() {
+	r.canInput = true
+}
+
 func (r *Result) Update() error {
+	if !r.canInput {
+		return nil
+	}
+
+	if isDismissed(r.input) {
+		r.canInput = false
+		r.sequence.SwitchWithTransition(r.nextScene, r.transition)
+		return nil
+	}
+
+	if r.input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) {
+		x, y := r.input.Mouse.CursorPosition()
+		if r.historyExporter.HandleClick(x, y) {
+			return nil
+		}
+		r.canInput = false
+		r.sequence.SwitchWithTransition(r.nextScene, r.transition)
+	}
 
 	return nil
 }
@@ -51,14 +81,36 @@ func (r *Result) Draw(screen *ebiten.Image) {
 	screen.Fill(color.RGBA{60, 40, 80, 255})
 
 	opt := &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(40, 360)
+	opt.GeoM.Translate(40, 40)
 	drawing.DrawText(screen, lang.Text("story-2"), 24, opt)
+
+	if r.viewModel == nil {
+		return
+	}
+
+	// Statistics (40,240)
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 240)
+	drawing.DrawText(screen, r.viewModel.Title(), 32, opt)
+
+	for i, text := range r.viewModel.StatTexts() {
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(40, 290+float64(i)*32)
+		drawing.DrawText(screen, text, 20, opt)
+	}
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 470)
+	drawing.DrawText(screen, r.viewModel.ScoreText(), 32, opt)
+
+	drawHistories(screen, r.historyViewModel, 640)
+	r.historyExporter.Draw(screen)
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(440, 640)
+	drawing.DrawText(screen, lang.Text("result-back-to-title"), 28, opt)
 }
 
 func (r *Result) Layout(outsideWidth, outsideHeight int) (int, int) {
 	return 1280, 720
 }
-
-func (r *Result) GetGameHistory() *GameHistory {
-	return r.history
-}
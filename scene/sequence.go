@@ -6,33 +6,56 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/bamenn"
 	"github.com/noppikinatta/bamenn/bamennutil"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
 	"github.com/noppikinatta/ebitenginegamejam2025/ui"
 )
 
-func CreateSequence(input *ui.Input) ebiten.Game {
-	title := NewTitle(input)
+func CreateSequence(input *ui.Input, settings *store.Settings) ebiten.Game {
+	title := NewTitle(input, settings)
 	inGame := NewInGame(input)
 	result := NewResult(input)
+	gameOver := NewGameOver(input)
+	highScores := NewHighScores(input)
+	achievements := NewAchievements(input)
+	settingsMenu := NewSettings(input, settings)
 	seq := bamenn.NewSequence(title)
 	tran := bamenn.NewLinearTransition(5, 10, bamennutil.LinearFillFadingDrawer{Color: color.Black})
 
 	title.Init(inGame, seq, tran)
+	title.InitHighScores(highScores)
+	title.InitAchievements(achievements)
+	title.InitSettings(settingsMenu)
 	inGame.Init(result, seq, tran)
+	inGame.InitGameOver(gameOver)
 	result.Init(title, seq, tran)
+	gameOver.Init(title, seq, tran)
+	highScores.Init(title, seq, tran)
+	achievements.Init(title, seq, tran)
+	settingsMenu.Init(title, seq, tran)
 
 	return &wrapperGame{
-		langSwitcher: &langSwitcher{},
+		input:        input,
+		langSwitcher: &langSwitcher{settings: settings},
 		game:         seq,
 	}
 }
 
+// isDismissed returns true if the player closes a screen without the mouse.
+func isDismissed(input *ui.Input) bool {
+	return input.IsActionJustPressed(ui.ActionConfirm) || input.IsActionJustPressed(ui.ActionBack)
+}
+
 type wrapperGame struct {
+	input        *ui.Input
 	langSwitcher *langSwitcher
 	game         ebiten.Game
 }
 
 func (w *wrapperGame) Update() error {
+	w.input.Update()
 	w.langSwitcher.Update()
+	sound.Update()
 	return w.game.Update()
 }
 
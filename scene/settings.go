@@ -0,0 +1,225 @@
+package scene
+
+import (
+	"image/color"
+	"math"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/bamenn"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/load"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+	"github.com/noppikinatta/ebitenginegamejam2025/ui"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
+)
+
+// volumeStep is the change of a volume by a click.
+const volumeStep = 0.1
+
+// ApplySettings applies the language, the volumes and the window settings. It is called at startup and whenever a setting changes.
+func ApplySettings(settings *store.Settings) {
+	if !lang.SetLanguage(settings.Language) {
+		// The language of a removed translation pack falls back to the current one.
+		settings.Language = lang.Current()
+	}
+	sound.SetVolumes(settings.MasterVolume, settings.BGMVolume, settings.SEVolume)
+	ebiten.SetWindowSize(settings.WindowSize.Width, settings.WindowSize.Height)
+	ebiten.SetFullscreen(settings.Fullscreen)
+}
+
+// Settings is the scene editing the user settings. The settings are saved when going back to the title.
+type Settings struct {
+	settings   *store.Settings
+	viewModel  *viewmodel.SettingsViewModel
+	input      *ui.Input
+	canInput   bool
+	focusedRow int // Row focused by the keyboard or a gamepad. NumSettingsRows is the back button.
+	nextScene  ebiten.Game
+	sequence   *bamenn.Sequence
+	transition bamenn.Transition
+}
+
+func NewSettings(input *ui.Input, settings *store.Settings) *Settings {
+	return &Settings{
+		settings:  settings,
+		viewModel: viewmodel.NewSettingsViewModel(settings),
+		input:     input,
+	}
+}
+
+func (s *Settings) Init(nextScene ebiten.Game, sequence *bamenn.Sequence, transition bamenn.Transition) {
+	s.nextScene = nextScene
+	s.sequence = sequence
+	s.transition = transition
+}
+
+func (s *Settings) OnStart() {
+	s.canInput = false
+	sound.PlayBGM(asset.BGMTitle)
+}
+
+func (s *Settings) OnArrival() {
+	s.canInput = true
+}
+
+func (s *Settings) Update() error {
+	if !s.canInput {
+		return nil
+	}
+	s.handleFocus()
+	if !s.input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) {
+		return nil
+	}
+
+	x, y := s.input.Mouse.CursorPosition()
+
+	// Back button (440,640,400,40)
+	if x >= 440 && x < 840 && y >= 640 && y < 680 {
+		s.back()
+		return nil
+	}
+
+	// Arrow buttons of the rows: "<" (600,y,48,48) and ">" (980,y,48,48)
+	for i := range viewmodel.NumSettingsRows {
+		top := settingsRowY(i)
+		if y < top || y >= top+48 {
+			continue
+		}
+		switch {
+		case x >= 600 && x < 648:
+			s.change(viewmodel.SettingsRow(i), -1)
+		case x >= 980 && x < 1028:
+			s.change(viewmodel.SettingsRow(i), 1)
+		}
+	}
+
+	return nil
+}
+
+// handleFocus moves the focused row up and down and changes its value left and right.
+func (s *Settings) handleFocus() {
+	if s.input.IsActionJustPressed(ui.ActionBack) {
+		s.back()
+		return
+	}
+
+	dx, dy, ok := s.input.Direction()
+	switch {
+	case ok && dy != 0:
+		s.focusedRow = min(max(s.focusedRow+dy, 0), viewmodel.NumSettingsRows)
+	case ok && s.focusedRow < viewmodel.NumSettingsRows:
+		s.change(viewmodel.SettingsRow(s.focusedRow), dx)
+	case s.input.IsActionJustPressed(ui.ActionConfirm):
+		if s.focusedRow == viewmodel.NumSettingsRows {
+			s.back()
+			return
+		}
+		s.change(viewmodel.SettingsRow(s.focusedRow), 1)
+	}
+}
+
+// back saves the settings and goes back to the title.
+func (s *Settings) back() {
+	// The settings stay in effect for this launch even if they cannot be saved.
+	_ = store.SaveSettings(s.settings)
+	s.canInput = false
+	s.sequence.SwitchWithTransition(s.nextScene, s.transition)
+}
+
+// change steps the value of the row forwards or backwards by delta.
+func (s *Settings) change(row viewmodel.SettingsRow, delta int) {
+	switch row {
+	case viewmodel.SettingsRowLanguage:
+		languages := lang.Languages()
+		s.settings.Language = languages[cycle(slices.Index(languages, s.settings.Language), delta, len(languages))]
+	case viewmodel.SettingsRowMasterVolume:
+		s.settings.MasterVolume = stepVolume(s.settings.MasterVolume, delta)
+	case viewmodel.SettingsRowBGMVolume:
+		s.settings.BGMVolume = stepVolume(s.settings.BGMVolume, delta)
+	case viewmodel.SettingsRowSEVolume:
+		s.settings.SEVolume = stepVolume(s.settings.SEVolume, delta)
+		sound.PlaySE(asset.SECardPlayed) // Let the player hear the new volume.
+	case viewmodel.SettingsRowWindowSize:
+		s.settings.WindowSize = store.WindowSizes[cycle(slices.Index(store.WindowSizes, s.settings.WindowSize), delta, len(store.WindowSizes))]
+	case viewmodel.SettingsRowFullscreen:
+		s.settings.Fullscreen = !s.settings.Fullscreen
+	case viewmodel.SettingsRowDifficulty:
+		difficulties := load.Difficulties()
+		idx := slices.IndexFunc(difficulties, func(d *core.Difficulty) bool { return string(d.ID) == s.settings.Difficulty })
+		s.settings.Difficulty = string(difficulties[cycle(idx, delta, len(difficulties))].ID)
+	}
+	ApplySettings(s.settings)
+}
+
+// cycle returns the index moved by delta, wrapping around n. An index not found (-1) moves from the start.
+func cycle(idx, delta, n int) int {
+	if idx < 0 {
+		return 0
+	}
+	return ((idx+delta)%n + n) % n
+}
+
+func stepVolume(volume float64, delta int) float64 {
+	v := math.Round((volume+float64(delta)*volumeStep)*10) / 10
+	return min(max(v, 0), 1)
+}
+
+func settingsRowY(i int) int {
+	return 140 + i*70
+}
+
+func (s *Settings) Draw(screen *ebiten.Image) {
+	// Background color
+	screen.Fill(color.RGBA{20, 20, 40, 255})
+
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(40, 40)
+	drawing.DrawText(screen, s.viewModel.Title(), 48, opt)
+
+	// Highlight of the focused row
+	if s.input.FocusVisible() {
+		if s.focusedRow < viewmodel.NumSettingsRows {
+			drawing.DrawRect(screen, 180, float64(settingsRowY(s.focusedRow)-6), 868, 60, 0.3, 0.3, 0.5, 1.0)
+		} else {
+			drawing.DrawRect(screen, 434, 634, 412, 52, 0.6, 0.5, 0.2, 1.0)
+		}
+	}
+
+	for i := range viewmodel.NumSettingsRows {
+		row := viewmodel.SettingsRow(i)
+		y := float64(settingsRowY(i))
+
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(200, y+8)
+		drawing.DrawText(screen, s.viewModel.Label(row), 28, opt)
+
+		drawing.DrawRect(screen, 600, y, 48, 48, 0.2, 0.2, 0.4, 1.0)
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(614, y+8)
+		drawing.DrawText(screen, "<", 28, opt)
+
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(672, y+8)
+		drawing.DrawText(screen, s.viewModel.Value(row), 28, opt)
+
+		drawing.DrawRect(screen, 980, y, 48, 48, 0.2, 0.2, 0.4, 1.0)
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(994, y+8)
+		drawing.DrawText(screen, ">", 28, opt)
+	}
+
+	// Back button (440,640,400,40)
+	drawing.DrawRect(screen, 440, 640, 400, 40, 0.2, 0.2, 0.4, 1.0)
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(456, 646)
+	drawing.DrawText(screen, s.viewModel.BackText(), 24, opt)
+}
+
+func (s *Settings) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return 1280, 720
+}
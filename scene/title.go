@@ -5,21 +5,50 @@ import (
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/bamenn"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/load"
+	"github.com/noppikinatta/ebitenginegamejam2025/sound"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
 	"github.com/noppikinatta/ebitenginegamejam2025/ui"
 )
 
 type Title struct {
-	input      *ui.Input
-	nextScene  ebiten.Game
-	sequence   *bamenn.Sequence
-	transition bamenn.Transition
+	input        *ui.Input
+	nextScene    ebiten.Game
+	highScores   ebiten.Game
+	achievements ebiten.Game
+	settingsMenu ebiten.Game
+	settings     *store.Settings
+	difficulties []*core.Difficulty
+	difficulty   int // Index of the selected difficulty
+	sequence     *bamenn.Sequence
+	transition   bamenn.Transition
 }
 
-func NewTitle(input *ui.Input) *Title {
-	return &Title{
-		input: input,
+func NewTitle(input *ui.Input, settings *store.Settings) *Title {
+	t := &Title{
+		input:        input,
+		settings:     settings,
+		difficulties: load.Difficulties(),
+	}
+	t.selectDefaultDifficulty()
+	return t
+}
+
+// selectDefaultDifficulty selects the difficulty of the settings, or Normal if it is unknown.
+func (t *Title) selectDefaultDifficulty() {
+	for i, d := range t.difficulties {
+		if d.ID == load.DifficultyNormal {
+			t.difficulty = i
+		}
+	}
+	for i, d := range t.difficulties {
+		if string(d.ID) == t.settings.Difficulty {
+			t.difficulty = i
+		}
 	}
 }
 
@@ -29,14 +58,76 @@ func (t *Title) Init(nextScene ebiten.Game, sequence *bamenn.Sequence, transitio
 	t.transition = transition
 }
 
+// InitHighScores sets the scene showing the high score table.
+func (t *Title) InitHighScores(highScores ebiten.Game) {
+	t.highScores = highScores
+}
+
+// InitAchievements sets the scene showing the achievements.
+func (t *Title) InitAchievements(achievements ebiten.Game) {
+	t.achievements = achievements
+}
+
+// InitSettings sets the scene editing the settings.
+func (t *Title) InitSettings(settingsMenu ebiten.Game) {
+	t.settingsMenu = settingsMenu
+}
+
+// OnStart selects the default difficulty, which may have been changed in the settings, and starts the BGM.
+func (t *Title) OnStart() {
+	t.selectDefaultDifficulty()
+	sound.PlayBGM(asset.BGMTitle)
+}
+
 func (t *Title) Update() error {
+	// Left and right choose the difficulty, and confirmation starts the game.
+	if dx, _, ok := t.input.Direction(); ok && dx != 0 {
+		t.difficulty = cycle(t.difficulty, dx, len(t.difficulties))
+		return nil
+	}
+	if t.input.IsActionJustPressed(ui.ActionConfirm) {
+		t.start()
+		return nil
+	}
+
 	if t.input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) {
-		t.sequence.SwitchWithTransition(t.nextScene, t.transition)
+		// Click detection for the high score button (1040,640,200,40).
+		x, y := t.input.Mouse.CursorPosition()
+		if t.highScores != nil && x >= 1040 && x < 1240 && y >= 640 && y < 680 {
+			t.sequence.SwitchWithTransition(t.highScores, t.transition)
+			return nil
+		}
+		// Click detection for the achievements button (1040,590,200,40).
+		if t.achievements != nil && x >= 1040 && x < 1240 && y >= 590 && y < 630 {
+			t.sequence.SwitchWithTransition(t.achievements, t.transition)
+			return nil
+		}
+		// Click detection for the settings button (1040,540,200,40).
+		if t.settingsMenu != nil && x >= 1040 && x < 1240 && y >= 540 && y < 580 {
+			t.sequence.SwitchWithTransition(t.settingsMenu, t.transition)
+			return nil
+		}
+		// Click detection for the difficulty buttons (40+i*110,660,100,40).
+		if y >= 660 && y < 700 && x >= 40 {
+			if idx := (x - 40) / 110; idx < len(t.difficulties) && (x-40)%110 < 100 {
+				t.difficulty = idx
+				return nil
+			}
+		}
+		t.start()
 	}
 
 	return nil
 }
 
+// start starts the game with the selected difficulty.
+func (t *Title) start() {
+	if receiver, ok := t.nextScene.(difficultyReceiver); ok {
+		receiver.SetDifficulty(t.difficulties[t.difficulty])
+	}
+	t.sequence.SwitchWithTransition(t.nextScene, t.transition)
+}
+
 func (t *Title) Draw(screen *ebiten.Image) {
 	// Set background color
 	screen.Fill(color.RGBA{20, 20, 40, 255})
@@ -52,8 +143,39 @@ func (t *Title) Draw(screen *ebiten.Image) {
 	drawing.DrawText(screen, lang.Text("story-1"), 24, opt)
 
 	opt = &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(440, 640)
+	opt.GeoM.Translate(520, 640)
 	drawing.DrawText(screen, "Click to Start", 28, opt)
+
+	// Difficulty buttons (40+i*110,660,100,40)
+	for i, d := range t.difficulties {
+		x := 40 + float64(i)*110
+		if i == t.difficulty {
+			drawing.DrawRect(screen, x, 660, 100, 40, 0.6, 0.5, 0.2, 1.0)
+		} else {
+			drawing.DrawRect(screen, x, 660, 100, 40, 0.2, 0.2, 0.4, 1.0)
+		}
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(x+6, 668)
+		drawing.DrawText(screen, lang.Text(string(d.ID)), 20, opt)
+	}
+
+	// High score button (1040,640,200,40)
+	drawing.DrawRect(screen, 1040, 640, 200, 40, 0.2, 0.2, 0.4, 1.0)
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(1056, 646)
+	drawing.DrawText(screen, lang.Text("highscore-title"), 24, opt)
+
+	// Achievements button (1040,590,200,40)
+	drawing.DrawRect(screen, 1040, 590, 200, 40, 0.2, 0.2, 0.4, 1.0)
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(1056, 596)
+	drawing.DrawText(screen, lang.Text("achievements-title"), 24, opt)
+
+	// Settings button (1040,540,200,40)
+	drawing.DrawRect(screen, 1040, 540, 200, 40, 0.2, 0.2, 0.4, 1.0)
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(1056, 546)
+	drawing.DrawText(screen, lang.Text("settings-title"), 24, opt)
 }
 
 func (t *Title) Layout(outsideWidth, outsideHeight int) (int, int) {
@@ -0,0 +1,175 @@
+// Package sound plays the BGM and the sound effects with the master, BGM and SE volumes.
+package sound
+
+import (
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/noppikinatta/ebitenginegamejam2025/asset"
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+// CrossfadeFrames is the number of frames a BGM takes to fade in or out.
+const CrossfadeFrames = 60
+
+// eventSounds are the sound effects played for the game events.
+var eventSounds = map[core.EventType]asset.Sound{
+	core.EventTypeCardPlayed:            asset.SECardPlayed,
+	core.EventTypeConstructionCommitted: asset.SEConstruction,
+	core.EventTypePackOpened:            asset.SEPackOpened,
+	core.EventTypeBattleWon:             asset.SEExplosion,
+	core.EventTypeBattleLost:            asset.SEBattleLost,
+	core.EventTypeTurnEnded:             asset.SETurnEnded,
+	core.EventTypeVictory:               asset.SEVictory,
+}
+
+var manager = &Manager{
+	master: 1,
+	bgm:    1,
+	se:     1,
+	ses:    make(map[asset.Sound]*audio.Player),
+}
+
+// SetVolumes sets the master, BGM and SE volumes from 0 to 1.
+func SetVolumes(master, bgm, se float64) {
+	manager.SetVolumes(master, bgm, se)
+}
+
+// PlaySE plays a sound effect from the start.
+func PlaySE(sound asset.Sound) {
+	manager.PlaySE(sound)
+}
+
+// PlayBGM crossfades the current BGM to sound. Nothing happens if sound is already playing.
+func PlayBGM(sound asset.Sound) {
+	manager.PlayBGM(sound)
+}
+
+// StopBGM fades out the current BGM.
+func StopBGM() {
+	manager.StopBGM()
+}
+
+// Update advances the fades. Call it every frame.
+func Update() {
+	manager.Update()
+}
+
+// HandleEvent is an EventListener to be passed to GameState.Subscribe. It plays the sound effect of the event.
+func HandleEvent(event *core.Event, gameState *core.GameState) {
+	if sound, ok := eventSounds[event.Type]; ok {
+		manager.PlaySE(sound)
+	}
+}
+
+// Manager owns the players of the BGM and the sound effects.
+type Manager struct {
+	master, bgm, se float64
+	ses             map[asset.Sound]*audio.Player // ses are the players of the sound effects, created on first use.
+	current         *track                        // current is the BGM playing or fading in.
+	fadingOut       []*track
+}
+
+// track is a BGM player with its fade.
+type track struct {
+	sound  asset.Sound
+	player *audio.Player
+	fade   float64 // fade is the volume of the fade from 0 to 1.
+}
+
+// SetVolumes sets the master, BGM and SE volumes from 0 to 1.
+func (m *Manager) SetVolumes(master, bgm, se float64) {
+	m.master, m.bgm, m.se = master, bgm, se
+	for sound, player := range m.ses {
+		player.SetVolume(m.seVolume(sound))
+	}
+	m.updateBGMVolumes()
+}
+
+// PlaySE plays a sound effect from the start.
+func (m *Manager) PlaySE(sound asset.Sound) {
+	player, ok := m.ses[sound]
+	if !ok {
+		var err error
+		player, err = asset.NewSoundPlayer(sound)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		m.ses[sound] = player
+	}
+
+	if err := player.Rewind(); err != nil {
+		log.Println(err)
+	}
+	player.SetVolume(m.seVolume(sound))
+	player.Play()
+}
+
+// PlayBGM crossfades the current BGM to sound. Nothing happens if sound is already playing.
+func (m *Manager) PlayBGM(sound asset.Sound) {
+	if m.current != nil && m.current.sound == sound {
+		return
+	}
+
+	player, err := asset.NewSoundPlayer(sound)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	m.StopBGM()
+	m.current = &track{sound: sound, player: player}
+	m.updateBGMVolumes()
+	player.Play()
+}
+
+// StopBGM fades out the current BGM.
+func (m *Manager) StopBGM() {
+	if m.current == nil {
+		return
+	}
+	m.fadingOut = append(m.fadingOut, m.current)
+	m.current = nil
+}
+
+// Update advances the fades. Call it every frame.
+func (m *Manager) Update() {
+	const step = 1.0 / CrossfadeFrames
+
+	if m.current != nil {
+		m.current.fade = min(m.current.fade+step, 1)
+	}
+
+	playing := m.fadingOut[:0]
+	for _, t := range m.fadingOut {
+		t.fade -= step
+		if t.fade > 0 {
+			playing = append(playing, t)
+			continue
+		}
+		if err := t.player.Close(); err != nil {
+			log.Println(err)
+		}
+	}
+	m.fadingOut = playing
+
+	m.updateBGMVolumes()
+}
+
+func (m *Manager) updateBGMVolumes() {
+	if m.current != nil {
+		m.current.player.SetVolume(m.bgmVolume(m.current))
+	}
+	for _, t := range m.fadingOut {
+		t.player.SetVolume(m.bgmVolume(t))
+	}
+}
+
+func (m *Manager) bgmVolume(t *track) float64 {
+	return asset.SoundVolume(t.sound) * m.bgm * m.master * t.fade
+}
+
+func (m *Manager) seVolume(sound asset.Sound) float64 {
+	return asset.SoundVolume(sound) * m.se * m.master
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+const (
+	highScoreFileName = "highscores.json"
+	MaxHighScores     = 10 // MaxHighScores is the number of entries kept in the HighScoreTable.
+)
+
+// HighScore is a single entry of the HighScoreTable.
+type HighScore struct {
+	Score      int       `json:"score"`
+	Seed       int64     `json:"seed"`
+	Date       time.Time `json:"date"`
+	Difficulty string    `json:"difficulty"`
+	Turns      int       `json:"turns"`
+	Victory    bool      `json:"victory"`
+	// TerritoryLevels maps the held territory IDs to their development levels at the end of the run.
+	TerritoryLevels map[string]int `json:"territory_levels,omitempty"`
+}
+
+// NewHighScore creates a HighScore from the finished GameState.
+func NewHighScore(gameState *core.GameState, date time.Time) HighScore {
+	difficulty := ""
+	if gameState.Difficulty != nil {
+		difficulty = string(gameState.Difficulty.ID)
+	}
+
+	levels := make(map[string]int)
+	for id, level := range gameState.TerritoryLevels() {
+		levels[string(id)] = level
+	}
+
+	return HighScore{
+		Score:           gameState.Score(),
+		Seed:            gameState.Seed,
+		Date:            date,
+		Difficulty:      difficulty,
+		Turns:           int(gameState.CurrentTurn),
+		Victory:         gameState.IsVictory(),
+		TerritoryLevels: levels,
+	}
+}
+
+// HighScoreTable is the list of the best scores, sorted in descending order.
+type HighScoreTable struct {
+	Entries []HighScore `json:"entries"`
+}
+
+// Add adds the entry and keeps the best MaxHighScores entries.
+// It returns the rank of the entry starting from 0, or -1 if the entry did not make it into the table.
+func (t *HighScoreTable) Add(entry HighScore) int {
+	// The entry goes after the ones with the same score.
+	rank := sort.Search(len(t.Entries), func(i int) bool {
+		return t.Entries[i].Score < entry.Score
+	})
+	t.Entries = slices.Insert(t.Entries, rank, entry)
+
+	if len(t.Entries) > MaxHighScores {
+		t.Entries = t.Entries[:MaxHighScores]
+	}
+	if rank >= MaxHighScores {
+		return -1
+	}
+	return rank
+}
+
+// LoadHighScores loads the HighScoreTable from the local file. A missing file gives an empty table.
+func LoadHighScores() (*HighScoreTable, error) {
+	table := &HighScoreTable{}
+	if err := loadJSON(highScoreFileName, table); err != nil {
+		return &HighScoreTable{}, err
+	}
+	return table, nil
+}
+
+// SaveHighScores saves the HighScoreTable to the local file.
+func SaveHighScores(table *HighScoreTable) error {
+	return saveJSON(highScoreFileName, table)
+}
+
+// RecordHighScore adds the result of the finished GameState to the local HighScoreTable.
+func RecordHighScore(gameState *core.GameState) (int, error) {
+	table, err := LoadHighScores()
+	if err != nil {
+		return -1, err
+	}
+
+	rank := table.Add(NewHighScore(gameState, time.Now()))
+	if err := SaveHighScores(table); err != nil {
+		return -1, err
+	}
+	return rank, nil
+}
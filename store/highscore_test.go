@@ -0,0 +1,98 @@
+package store_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+)
+
+func scores(table *store.HighScoreTable) []int {
+	result := make([]int, 0, len(table.Entries))
+	for _, entry := range table.Entries {
+		result = append(result, entry.Score)
+	}
+	return result
+}
+
+func TestHighScoreTable_Add(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   []int
+		score      int
+		wantRank   int
+		wantScores []int
+	}{
+		{
+			name:       "Empty table",
+			score:      100,
+			wantRank:   0,
+			wantScores: []int{100},
+		},
+		{
+			name:       "Middle of the table",
+			existing:   []int{300, 100},
+			score:      200,
+			wantRank:   1,
+			wantScores: []int{300, 200, 100},
+		},
+		{
+			name:       "Same score goes after the existing entry",
+			existing:   []int{300, 200},
+			score:      200,
+			wantRank:   2,
+			wantScores: []int{300, 200, 200},
+		},
+		{
+			name:       "Full table drops the lowest entry",
+			existing:   []int{100, 90, 80, 70, 60, 50, 40, 30, 20, 10},
+			score:      55,
+			wantRank:   5,
+			wantScores: []int{100, 90, 80, 70, 60, 55, 50, 40, 30, 20},
+		},
+		{
+			name:       "Score too low for a full table",
+			existing:   []int{100, 90, 80, 70, 60, 50, 40, 30, 20, 10},
+			score:      10,
+			wantRank:   -1,
+			wantScores: []int{100, 90, 80, 70, 60, 50, 40, 30, 20, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := &store.HighScoreTable{}
+			for _, score := range tt.existing {
+				table.Entries = append(table.Entries, store.HighScore{Score: score})
+			}
+
+			if got := table.Add(store.HighScore{Score: tt.score, Seed: 42}); got != tt.wantRank {
+				t.Errorf("Add() = %d, want %d", got, tt.wantRank)
+			}
+			if got := scores(table); !slices.Equal(got, tt.wantScores) {
+				t.Errorf("scores = %v, want %v", got, tt.wantScores)
+			}
+			if tt.wantRank >= 0 && table.Entries[tt.wantRank].Seed != 42 {
+				t.Errorf("Entries[%d] is not the added entry", tt.wantRank)
+			}
+		})
+	}
+}
+
+func TestSaveHighScores(t *testing.T) {
+	useTempConfigDir(t)
+
+	table := &store.HighScoreTable{}
+	table.Add(store.HighScore{Score: 100, Difficulty: "difficulty-hard", TerritoryLevels: map[string]int{"territory-goblin": 2}})
+	if err := store.SaveHighScores(table); err != nil {
+		t.Fatalf("SaveHighScores() failed: %v", err)
+	}
+
+	loaded, err := store.LoadHighScores()
+	if err != nil {
+		t.Fatalf("LoadHighScores() failed: %v", err)
+	}
+	if len(loaded.Entries) != 1 || loaded.Entries[0].Score != 100 || loaded.Entries[0].TerritoryLevels["territory-goblin"] != 2 {
+		t.Errorf("LoadHighScores() = %+v, want the saved table", loaded.Entries)
+	}
+}
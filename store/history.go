@@ -0,0 +1,45 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+)
+
+// HistoryFormat is the file format of an exported history log.
+type HistoryFormat string
+
+const (
+	HistoryFormatJSON HistoryFormat = "json"
+	HistoryFormatCSV  HistoryFormat = "csv"
+)
+
+// ExportHistories writes the history log to a new file in the given format and returns its path.
+func ExportHistories(histories core.Histories, format HistoryFormat, now time.Time) (string, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case HistoryFormatJSON:
+		err = histories.WriteJSON(&buf)
+	case HistoryFormatCSV:
+		err = histories.WriteCSV(&buf)
+	default:
+		err = fmt.Errorf("store: unknown history format %q", format)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	p, err := path(fmt.Sprintf("history-%s.%s", now.Format("20060102-150405"), format))
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return "", err
+	}
+	return p, os.WriteFile(p, buf.Bytes(), 0o644)
+}
@@ -0,0 +1,8 @@
+package store
+
+const translationPackDirName = "lang"
+
+// TranslationPackDir returns the directory where players put translation pack CSVs.
+func TranslationPackDir() (string, error) {
+	return path(translationPackDirName)
+}
@@ -0,0 +1,36 @@
+package store
+
+import (
+	"time"
+)
+
+const profileFileName = "profile.json"
+
+// Profile is the local data kept across runs.
+type Profile struct {
+	Achievements map[string]time.Time `json:"achievements"` // Achievements maps the unlocked achievement IDs to the unlock dates.
+}
+
+// LoadProfile loads the Profile from the local file. A missing file gives an empty Profile.
+func LoadProfile() (*Profile, error) {
+	profile := &Profile{}
+	err := loadJSON(profileFileName, profile)
+	if profile.Achievements == nil {
+		profile.Achievements = make(map[string]time.Time)
+	}
+	return profile, err
+}
+
+// SaveProfile saves the Profile to the local file.
+func SaveProfile(profile *Profile) error {
+	return saveJSON(profileFileName, profile)
+}
+
+// UnlockAchievement records the achievement as unlocked at date. It returns false if it was already unlocked.
+func (p *Profile) UnlockAchievement(id string, date time.Time) bool {
+	if _, ok := p.Achievements[id]; ok {
+		return false
+	}
+	p.Achievements[id] = date
+	return true
+}
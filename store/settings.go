@@ -0,0 +1,66 @@
+package store
+
+import "github.com/noppikinatta/ebitenginegamejam2025/load"
+
+const settingsFileName = "settings.json"
+
+// WindowSize is a size of the window in pixels.
+type WindowSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// WindowSizes are the window sizes selectable in the settings. They keep the 16:9 aspect ratio of the screen.
+var WindowSizes = []WindowSize{
+	{Width: 960, Height: 540},
+	{Width: 1280, Height: 720},
+	{Width: 1600, Height: 900},
+	{Width: 1920, Height: 1080},
+}
+
+// Settings is the user configuration kept across launches.
+type Settings struct {
+	Language     string     `json:"language"`     // Language is the name of the language, such as "english".
+	MasterVolume float64    `json:"masterVolume"` // MasterVolume is applied to both the BGM and the sound effects, from 0 to 1.
+	BGMVolume    float64    `json:"bgmVolume"`    // BGMVolume is the volume of the background music from 0 to 1.
+	SEVolume     float64    `json:"seVolume"`     // SEVolume is the volume of the sound effects from 0 to 1.
+	WindowSize   WindowSize `json:"windowSize"`   // WindowSize is the size of the window when it is not fullscreen.
+	Fullscreen   bool       `json:"fullscreen"`
+	Difficulty   string     `json:"difficulty"` // Difficulty is the ID of the difficulty selected on the title screen by default.
+}
+
+// DefaultSettings returns the Settings used before the player changes anything.
+func DefaultSettings() *Settings {
+	return &Settings{
+		Language:     "english",
+		MasterVolume: 1.0,
+		BGMVolume:    1.0,
+		SEVolume:     1.0,
+		WindowSize:   WindowSizes[1],
+		Difficulty:   string(load.DifficultyNormal),
+	}
+}
+
+// LoadSettings loads the Settings from the local file.
+// A missing file gives the DefaultSettings, and the fields missing from the file keep their defaults.
+func LoadSettings() (*Settings, error) {
+	settings := DefaultSettings()
+	err := loadJSON(settingsFileName, settings)
+	settings.clamp()
+	return settings, err
+}
+
+// SaveSettings saves the Settings to the local file.
+func SaveSettings(settings *Settings) error {
+	return saveJSON(settingsFileName, settings)
+}
+
+// clamp fixes the values edited by hand to be in range.
+func (s *Settings) clamp() {
+	s.MasterVolume = min(max(s.MasterVolume, 0), 1)
+	s.BGMVolume = min(max(s.BGMVolume, 0), 1)
+	s.SEVolume = min(max(s.SEVolume, 0), 1)
+	if s.WindowSize.Width <= 0 || s.WindowSize.Height <= 0 {
+		s.WindowSize = WindowSizes[1]
+	}
+}
@@ -0,0 +1,86 @@
+package store_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/load"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+)
+
+// useTempConfigDir makes the store use a temporary directory and returns the app directory in it.
+func useTempConfigDir(t *testing.T) string {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("AppData", dir)
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		t.Fatalf("UserConfigDir() failed: %v", err)
+	}
+	return filepath.Join(configDir, "ebitenginegamejam2025")
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadSettings_Defaults(t *testing.T) {
+	useTempConfigDir(t)
+
+	settings, err := store.LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings() failed: %v", err)
+	}
+	if *settings != *store.DefaultSettings() {
+		t.Errorf("LoadSettings() = %+v, want the defaults", settings)
+	}
+	if settings.Difficulty != string(load.DifficultyNormal) {
+		t.Errorf("Difficulty = %q, want %q", settings.Difficulty, load.DifficultyNormal)
+	}
+}
+
+func TestLoadSettings_Clamp(t *testing.T) {
+	dir := useTempConfigDir(t)
+	writeFile(t, dir, "settings.json", `{"masterVolume": 2, "bgmVolume": -1, "seVolume": 0.5, "windowSize": {"width": 0, "height": 0}}`)
+
+	settings, err := store.LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings() failed: %v", err)
+	}
+
+	want := store.DefaultSettings()
+	want.MasterVolume = 1
+	want.BGMVolume = 0
+	want.SEVolume = 0.5
+	if *settings != *want {
+		t.Errorf("LoadSettings() = %+v, want %+v", settings, want)
+	}
+}
+
+func TestSaveSettings(t *testing.T) {
+	useTempConfigDir(t)
+
+	settings := store.DefaultSettings()
+	settings.Language = "japanese"
+	settings.WindowSize = store.WindowSizes[3]
+	settings.Fullscreen = true
+	if err := store.SaveSettings(settings); err != nil {
+		t.Fatalf("SaveSettings() failed: %v", err)
+	}
+
+	loaded, err := store.LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings() failed: %v", err)
+	}
+	if *loaded != *settings {
+		t.Errorf("LoadSettings() = %+v, want %+v", loaded, settings)
+	}
+}
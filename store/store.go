@@ -0,0 +1,58 @@
+// Package store saves and loads local data such as high scores.
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// appDirName is the directory name under the user config directory.
+const appDirName = "ebitenginegamejam2025"
+
+// path returns the path of the file with the given name in the app directory.
+func path(name string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, appDirName, name), nil
+}
+
+// loadJSON reads the JSON file with the given name into v. A missing file is not an error and leaves v untouched.
+func loadJSON(name string, v any) error {
+	p, err := path(name)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// saveJSON writes v to the JSON file with the given name.
+func saveJSON(name string, v any) error {
+	p, err := path(name)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0o644)
+}
@@ -1,11 +1,10 @@
 package ui
 
 import (
-	"fmt"
-
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/flow"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
 )
 
@@ -14,14 +13,19 @@ import (
 type BattleView struct {
 	BattleFlow      *flow.BattleFlow
 	BattleViewModel *viewmodel.BattleViewModel
+	ResultViewModel *viewmodel.BattleResultViewModel
 
 	HoveredCardIndex int
+
+	focus focusRing
+	drag  *cardDrag
 }
 
 // NewBattleView creates a BattleView.
-func NewBattleView(battleFlow *flow.BattleFlow) *BattleView {
+func NewBattleView(battleFlow *flow.BattleFlow, drag *cardDrag) *BattleView {
 	return &BattleView{
 		BattleFlow: battleFlow,
+		drag:       drag,
 	}
 }
 
@@ -31,68 +35,164 @@ func (bv *BattleView) Select(x, y int) {
 		return
 	}
 	bv.BattleViewModel = vm
+	bv.focus.Reset()
 }
 
 // HandleInput handles input.
 func (bv *BattleView) HandleInput(input *Input) (bool, error) {
+	if bv.ResultViewModel != nil {
+		// Any click or confirmation closes the result and goes back to the map.
+		if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) || input.IsActionJustPressed(ActionConfirm) || input.IsActionJustPressed(ActionBack) {
+			bv.ResultViewModel = nil
+			bv.BattleViewModel = nil
+			return true, nil
+		}
+		return false, nil
+	}
+
+	if input.IsActionJustPressed(ActionBack) {
+		return bv.retreat(), nil
+	}
+	if input.IsActionJustPressed(ActionProceed) {
+		return bv.conquer(), nil
+	}
+	if bv.focus.HandleInput(input, bv.focusTargets()) {
+		return true, nil
+	}
+
 	cursorX, cursorY := input.Mouse.CursorPosition()
 	cardIndex := bv.cardIndex(cursorX, cursorY)
 	bv.HoveredCardIndex = cardIndex
 
-	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
-		if cardIndex != -1 {
-			bv.handleBattleCardClick(cursorX, cursorY)
+	if input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) && cardIndex != -1 {
+		if card, ok := bv.BattleViewModel.Card(cardIndex); ok {
+			bv.drag.Start(cardDragFromPlaced, cardIndex, card, cursorX, cursorY)
 		}
+	}
+	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
+		bv.dropCard(cursorX, cursorY)
+	}
+	if bv.drag.Active() {
+		return false, nil
+	}
+	if bv.backButton().IsClicked(input) {
+		return bv.retreat(), nil
+	}
+	if bv.conquerButton().IsClicked(input) {
+		return bv.conquer(), nil
+	}
 
-		// Click detection for the back button (960,40,80,80).
-		if cursorX >= 960 && cursorX < 1040 && cursorY >= 40 && cursorY < 120 {
-			bv.BattleFlow.Rollback()
-			return true, nil
-		}
+	return false, nil
+}
 
-		// Click detection for the conquer button (400,560,240,40).
-		if cursorX >= 400 && cursorX < 640 && cursorY >= 560 && cursorY < 600 {
-			ok := bv.BattleFlow.Conquer()
-			if !ok {
-				bv.BattleFlow.Rollback()
-			}
-			return true, nil
-		}
+// dropCard finishes a click or a drag of a placed card. A click or a drop on the deck returns the card to the deck.
+func (bv *BattleView) dropCard(cursorX, cursorY int) {
+	from, dragged, ok := bv.drag.Drop(cardDragFromPlaced)
+	if !ok || bv.BattleViewModel == nil {
+		return
 	}
 
-	return false, nil
+	switch drop, to := dropPlaced(bv.cardSlots(), bv.BattleViewModel.NumCards(), from, dragged, cursorX, cursorY); drop {
+	case placedCardRemove:
+		bv.BattleFlow.RemoveFromBattle(from)
+	case placedCardMove:
+		bv.BattleFlow.MoveInBattle(from, to)
+	}
 }
 
-// cardIndex calculates which card index the cursor is over
-func (bv *BattleView) cardIndex(cursorX, cursorY int) int {
-	// Battle card area calculation
-	// This is a simplified implementation
-	if cursorY < 400 || cursorY >= 520 { // Card area roughly
-		return -1
+func (bv *BattleView) cardSlots() CardSlots {
+	return CardSlots{Rect: layoutRect(RegionPlacedCards)}
+}
+
+func (bv *BattleView) backButton() Button {
+	return Button{Rect: layoutRect(RegionMainBack), Text: "Back", TextSize: 20, Color: buttonColor}
+}
+
+// conquerButton returns the main button, which attacks or resolves the battle.
+func (bv *BattleView) conquerButton() Button {
+	button := Button{Rect: layoutRect(RegionMainAction), TextSize: 20, Color: buttonColorNegative}
+	if bv.BattleViewModel == nil {
+		return button
 	}
 
-	cardX := (cursorX - 100) / 80 // Assuming cards start at x=100 and are 80px wide
-	if cardX < 0 {
-		return -1
+	canWin := bv.BattleViewModel.CanBeat()
+	if canWin {
+		button.Color = buttonColorPositive
+	}
+	switch {
+	case bv.canAttack():
+		button.Text = lang.Text("battle-attack")
+	case !canWin:
+		button.Text = "Cannot Win"
+	default:
+		button.Text = "Conquer"
 	}
+	return button
+}
 
+// focusTargets returns the placed cards and the buttons in focus order.
+func (bv *BattleView) focusTargets() []focusTarget {
+	var targets []focusTarget
 	if bv.BattleViewModel != nil {
-		numCards := bv.BattleViewModel.NumCards()
-		if cardX >= numCards {
-			return -1
+		slots := bv.cardSlots()
+		for i := range bv.BattleViewModel.NumCards() {
+			targets = append(targets, focusTarget{
+				rect: slots.SlotRect(i),
+				activate: func() bool {
+					bv.BattleFlow.RemoveFromBattle(i)
+					return false
+				},
+			})
 		}
 	}
+	return append(targets,
+		bv.conquerButton().focusTarget(bv.conquer),
+		bv.backButton().focusTarget(bv.retreat),
+	)
+}
 
-	return cardX
+// retreat cancels the battle and returns true to go back to the map.
+func (bv *BattleView) retreat() bool {
+	bv.BattleFlow.Rollback()
+	return true
 }
 
-// handleBattleCardClick handles clicking on battle cards
-func (bv *BattleView) handleBattleCardClick(cursorX, cursorY int) {
-	cardIndex := bv.cardIndex(cursorX, cursorY)
-	if cardIndex != -1 && bv.BattleFlow != nil {
-		// Remove card from battlefield
-		bv.BattleFlow.RemoveFromBattle(cardIndex)
+// conquer attacks, moves to the next boss phase or resolves the battle.
+// It returns true if the battle is cancelled and the view should go back to the map.
+func (bv *BattleView) conquer() bool {
+	if bv.canAttack() {
+		bv.BattleFlow.Attack()
+		return false
+	}
+
+	if vm, ok := bv.BattleFlow.NextBossPhase(); ok {
+		bv.BattleViewModel = vm
+		return false
+	}
+
+	result, ok := bv.BattleFlow.Conquer()
+	if !ok {
+		bv.BattleFlow.Rollback()
+		return true
+	}
+	bv.ResultViewModel = result
+	return false
+}
+
+// canAttack returns true if the main button starts the next round instead of resolving the battle
+func (bv *BattleView) canAttack() bool {
+	if bv.BattleViewModel == nil || !bv.BattleViewModel.IsMultiRound() {
+		return false
+	}
+	return !bv.BattleViewModel.CanBeat() && !bv.BattleViewModel.IsFinalRound()
+}
+
+// cardIndex calculates which placed card the cursor is over
+func (bv *BattleView) cardIndex(cursorX, cursorY int) int {
+	if bv.BattleViewModel == nil {
+		return -1
 	}
+	return bv.cardSlots().IndexAt(cursorX, cursorY, bv.BattleViewModel.NumCards())
 }
 
 // Draw handles drawing.
@@ -105,6 +205,11 @@ func (bv *BattleView) Draw(screen *ebiten.Image) {
 		return
 	}
 
+	if bv.ResultViewModel != nil {
+		bv.drawResult(screen)
+		return
+	}
+
 	// Draw battle title
 	title := bv.BattleViewModel.Title()
 	opt := &ebiten.DrawImageOptions{}
@@ -122,6 +227,36 @@ func (bv *BattleView) Draw(screen *ebiten.Image) {
 
 	// Draw total power and battle result
 	bv.drawBattleStatus(screen)
+
+	// Draw the detail of the power calculation
+	bv.drawBreakdown(screen)
+
+	bv.focus.Draw(screen, bv.focusTargets())
+}
+
+// drawBreakdown draws the detail of the power calculation, the supporting territories and the terrain effects (700,150)
+func (bv *BattleView) drawBreakdown(screen *ebiten.Image) {
+	y := 150.0
+	for _, text := range bv.BattleViewModel.BreakdownTexts() {
+		opt := &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(700, y)
+		drawing.DrawText(screen, text, 18, opt)
+		y += 24
+	}
+
+	for _, text := range bv.BattleViewModel.SupportContributionTexts() {
+		opt := &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(716, y)
+		drawing.DrawText(screen, text, 14, opt)
+		y += 18
+	}
+
+	for _, text := range bv.BattleViewModel.TerrainEffectTexts() {
+		opt := &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(700, y)
+		drawing.DrawText(screen, text, 18, opt)
+		y += 24
+	}
 }
 
 // drawEnemyInfo draws enemy information
@@ -139,77 +274,76 @@ func (bv *BattleView) drawEnemyInfo(screen *ebiten.Image) {
 	power := bv.BattleViewModel.EnemyPower()
 	opt := &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(200, 150)
-	drawing.DrawText(screen, fmt.Sprintf("Power: %.1f", power), 24, opt)
+	drawing.DrawText(screen, lang.ExecuteTemplate("battle-power", map[string]any{"power": power}), 24, opt)
 
 	// Draw enemy type
 	enemyType := bv.BattleViewModel.EnemyType()
 	opt = &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(200, 180)
 	drawing.DrawText(screen, enemyType, 20, opt)
-}
 
-// drawBattleCards draws the placed battle cards
-func (bv *BattleView) drawBattleCards(screen *ebiten.Image) {
-	numCards := bv.BattleViewModel.NumCards()
+	if bv.BattleViewModel.IsMultiRound() {
+		bv.drawRoundInfo(screen)
+	}
+	if bv.BattleViewModel.IsMultiPhase() {
+		bv.drawPhaseInfo(screen)
+	}
+}
 
-	for i := 0; i < numCards; i++ {
-		x := float64(100 + i*80)
-		y := float64(400)
+// drawPhaseInfo draws the phase progress and the dialogue of a multi-phase boss
+func (bv *BattleView) drawPhaseInfo(screen *ebiten.Image) {
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(200, 280)
+	drawing.DrawText(screen, bv.BattleViewModel.PhaseText(), 20, opt)
 
-		cardVM, ok := bv.BattleViewModel.Card(i)
-		if !ok {
-			DrawCardBackground(screen, x, y, 0.5)
-			continue
-		}
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(200, 310)
+	drawing.DrawText(screen, bv.BattleViewModel.PhaseDialogue(), 20, opt)
+}
 
-		DrawCard(screen, x, y, cardVM, i == bv.HoveredCardIndex)
+// drawRoundInfo draws the enemy HP and the round progress of a multi-round battle
+func (bv *BattleView) drawRoundInfo(screen *ebiten.Image) {
+	hp := bv.BattleViewModel.EnemyHP()
+	maxHP := bv.BattleViewModel.EnemyPower()
 
-		maxCards := bv.BattleViewModel.CardSlot()
-		for i := numCards; i < maxCards; i++ {
-			x := float64(100 + i*80)
-			y := float64(400)
-			DrawCardBackground(screen, x, y, 0.3)
-		}
+	// HP gauge (200,220,300,20)
+	drawing.DrawRect(screen, 200, 220, 300, 20, 0.2, 0.2, 0.2, 1.0)
+	if maxHP > 0 && hp > 0 {
+		drawing.DrawRect(screen, 200, 220, 300*hp/maxHP, 20, 0.8, 0.2, 0.2, 1.0)
 	}
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(510, 216)
+	drawing.DrawText(screen, lang.ExecuteTemplate("battle-hp", map[string]any{"hp": hp, "maxHP": maxHP}), 20, opt)
+
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(200, 250)
+	drawing.DrawText(screen, bv.BattleViewModel.RoundText(), 20, opt)
+}
+
+// drawBattleCards draws the placed battle cards and the empty slots
+func (bv *BattleView) drawBattleCards(screen *ebiten.Image) {
+	bv.cardSlots().Draw(screen, bv.BattleViewModel.NumCards(), bv.BattleViewModel.CardSlot(), bv.BattleViewModel.Card, bv.HoveredCardIndex)
+	bv.drag.drawPlaced(screen, bv.cardSlots(), bv.BattleViewModel.NumCards())
 }
 
 // drawButtons draws UI buttons
 func (bv *BattleView) drawButtons(screen *ebiten.Image) {
-	// Back button (960,40,80,80)
-	drawing.DrawRect(screen, 960, 40, 80, 80, 0.3, 0.3, 0.3, 1.0)
-	opt := &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(980, 65)
-	drawing.DrawText(screen, "Back", 20, opt)
-
-	// Conquer button (400,560,240,40)
-	canWin := bv.BattleViewModel.CanBeat()
-	if canWin {
-		drawing.DrawRect(screen, 400, 560, 240, 40, 0.2, 0.6, 0.2, 1.0)
-	} else {
-		drawing.DrawRect(screen, 400, 560, 240, 40, 0.6, 0.2, 0.2, 1.0)
-	}
-
-	opt = &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(480, 575)
-	buttonText := "Conquer"
-	if !canWin {
-		buttonText = "Cannot Win"
-	}
-	drawing.DrawText(screen, buttonText, 20, opt)
+	bv.backButton().Draw(screen)
+	bv.conquerButton().Draw(screen)
 }
 
 // drawBattleStatus draws battle status information
 func (bv *BattleView) drawBattleStatus(screen *ebiten.Image) {
 	totalPower := bv.BattleViewModel.TotalPower()
-	enemyPower := bv.BattleViewModel.EnemyPower()
+	enemyPower := bv.BattleViewModel.EnemyCurrentPower()
 
 	opt := &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(400, 350)
-	drawing.DrawText(screen, fmt.Sprintf("Your Power: %.1f", totalPower), 24, opt)
+	drawing.DrawText(screen, lang.ExecuteTemplate("battle-your-power", map[string]any{"power": totalPower}), 24, opt)
 
 	opt = &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(600, 350)
-	drawing.DrawText(screen, fmt.Sprintf("Enemy: %.1f", enemyPower), 24, opt)
+	drawing.DrawText(screen, lang.ExecuteTemplate("battle-enemy-power", map[string]any{"power": enemyPower}), 24, opt)
 
 	canWin := bv.BattleViewModel.CanBeat()
 	opt = &ebiten.DrawImageOptions{}
@@ -220,3 +354,35 @@ func (bv *BattleView) drawBattleStatus(screen *ebiten.Image) {
 		drawing.DrawText(screen, "Need more power", 20, opt)
 	}
 }
+
+// drawResult draws the survivors and losses of the finished battle
+func (bv *BattleView) drawResult(screen *ebiten.Image) {
+	result := bv.ResultViewModel
+
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(20, 60)
+	drawing.DrawText(screen, result.Title(), 32, opt)
+
+	// Survivors
+	survivors := CardSlots{Rect: layoutRect(RegionSurvivors)}
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(survivors.Rect.X, survivors.Rect.Y-30)
+	drawing.DrawText(screen, lang.Text("battle-result-survivors"), 20, opt)
+	survivors.Draw(screen, result.NumSurvivors(), 0, result.Survivor, -1)
+
+	// Losses, darkened
+	losses := CardSlots{Rect: layoutRect(RegionLosses)}
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(losses.Rect.X, losses.Rect.Y-30)
+	drawing.DrawText(screen, lang.Text("battle-result-losses"), 20, opt)
+	losses.Draw(screen, result.NumLosses(), 0, result.Loss, -1)
+	for i := range result.NumLosses() {
+		rect := losses.SlotRect(i)
+		drawing.DrawRect(screen, rect.X, rect.Y, rect.Width, rect.Height, 0, 0, 0, 0.5)
+	}
+
+	action := layoutRect(RegionMainAction)
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(action.X, action.Y)
+	drawing.DrawText(screen, lang.Text("battle-result-close"), 20, opt)
+}
@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
+)
+
+// Colors of buttons
+var (
+	buttonColor         = drawing.NewColorF32(0.3, 0.3, 0.3, 1)
+	buttonColorLight    = drawing.NewColorF32(0.8, 0.8, 0.8, 1)
+	buttonColorPositive = drawing.NewColorF32(0.2, 0.6, 0.2, 1)
+	buttonColorNegative = drawing.NewColorF32(0.6, 0.2, 0.2, 1)
+	buttonColorEnabled  = drawing.NewColorF32(0.2, 0.4, 0.6, 1)
+	buttonColorPaging   = drawing.NewColorF32(0.25, 0.25, 0.3, 1)
+	buttonColorSelected = drawing.NewColorF32(0.3, 0.5, 0.7, 1)
+)
+
+// Button is a rectangular button. The same Rect is used for drawing and hit testing.
+type Button struct {
+	Rect geom.Rect
+	// ImageKey is the image drawn at the center. Text is drawn instead if it is empty.
+	ImageKey string
+	Text     string
+	TextSize float64
+	Color    drawing.ColorF32
+}
+
+// Contains returns true if the pixel (x, y) is on the button.
+func (b Button) Contains(x, y int) bool {
+	return b.Rect.Contains(x, y)
+}
+
+// IsClicked returns true if the left mouse button is released on the button.
+func (b Button) IsClicked(input *Input) bool {
+	if !input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
+		return false
+	}
+	return b.Contains(input.Mouse.CursorPosition())
+}
+
+// Draw draws the frame and the image or text at the center.
+func (b Button) Draw(screen *ebiten.Image) {
+	r, g, bl, a := b.Color.RGBA()
+	drawing.DrawRect(screen, b.Rect.X, b.Rect.Y, b.Rect.Width, b.Rect.Height, r, g, bl, a)
+
+	center := b.Rect.Center()
+	if b.ImageKey != "" {
+		image := drawing.Image(b.ImageKey)
+		bounds := image.Bounds()
+		opt := &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(center.X-float64(bounds.Dx())/2, center.Y-float64(bounds.Dy())/2)
+		screen.DrawImage(image, opt)
+		return
+	}
+	if b.Text == "" {
+		return
+	}
+	size := drawing.MeasureText(b.Text, b.TextSize)
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(center.X-size.X/2, center.Y-size.Y/2)
+	drawing.DrawText(screen, b.Text, b.TextSize, opt)
+}
+
+// focusTarget returns the button as a focus target activated by activate.
+func (b Button) focusTarget(activate func() (back bool)) focusTarget {
+	return focusTarget{rect: b.Rect, activate: activate}
+}
+
+// Card size in pixels
+const (
+	cardWidth  = 80
+	cardHeight = 120
+)
+
+// CardSlots is a row of card slots from the top left of Rect. The same geometry is used for drawing and hit testing.
+type CardSlots struct {
+	Rect geom.Rect
+}
+
+// SlotRect returns the rectangle of the i-th slot.
+func (s CardSlots) SlotRect(i int) geom.Rect {
+	return s.Rect.Row(i, cardWidth, cardHeight, 0)
+}
+
+// IndexAt returns the index of the slot at (x, y) among the first n slots, or -1 if there is none.
+func (s CardSlots) IndexAt(x, y, n int) int {
+	if float64(y) < s.Rect.Y || float64(y) >= s.Rect.Y+cardHeight || float64(x) < s.Rect.X {
+		return -1
+	}
+	idx := int((float64(x) - s.Rect.X) / cardWidth)
+	if idx >= n {
+		return -1
+	}
+	return idx
+}
+
+// InsertIndexAt returns the position between the slots nearest to (x, y), where a card dropped there is inserted.
+// A position past the placed cards means the end. It returns false if (x, y) is not on the row.
+func (s CardSlots) InsertIndexAt(x, y int) (int, bool) {
+	if float64(y) < s.Rect.Y || float64(y) >= s.Rect.Y+cardHeight {
+		return 0, false
+	}
+	if float64(x) < s.Rect.X-cardWidth/2 || float64(x) >= s.Rect.X+s.Rect.Width {
+		return 0, false
+	}
+	return max(int(math.Round((float64(x)-s.Rect.X)/cardWidth)), 0), true
+}
+
+// Draw draws numCards cards returned by card, and empty slots up to numSlots. The card at hovered is highlighted.
+func (s CardSlots) Draw(screen *ebiten.Image, numCards, numSlots int, card func(i int) (*viewmodel.CardViewModel, bool), hovered int) {
+	for i := range numCards {
+		rect := s.SlotRect(i)
+		cardVM, ok := card(i)
+		if !ok {
+			DrawCardBackground(screen, rect.X, rect.Y, 0.5)
+			continue
+		}
+		DrawCard(screen, rect.X, rect.Y, cardVM, i == hovered)
+	}
+
+	for i := numCards; i < numSlots; i++ {
+		rect := s.SlotRect(i)
+		DrawCardBackground(screen, rect.X, rect.Y, 0.3)
+	}
+}
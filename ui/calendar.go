@@ -7,7 +7,7 @@ import (
 )
 
 // CalendarView is a widget for displaying the calendar.
-// Position: (1040,0,240,40).
+// Position: RegionCalendar (1040,0,240,40).
 // Displays the current Turn in year/month format.
 type CalendarView struct {
 	ViewModel *viewmodel.CalendarViewModel
@@ -30,8 +30,15 @@ func (cv *CalendarView) Draw(screen *ebiten.Image) {
 	// Get formatted year/month from viewmodel
 	text := cv.ViewModel.YearMonth()
 
-	// Position: (1040,0,240,40).
+	area := layoutRect(RegionCalendar)
 	opt := &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(1040, 0)
+	opt.GeoM.Translate(area.X, area.Y)
 	drawing.DrawText(screen, text, 24, opt)
+
+	// Remaining turns before the Demon Lord fully awakens.
+	if remaining := cv.ViewModel.RemainingTurnsText(); remaining != "" {
+		opt = &ebiten.DrawImageOptions{}
+		opt.GeoM.Translate(area.X+140, area.Y+6)
+		drawing.DrawText(screen, remaining, 16, opt)
+	}
 }
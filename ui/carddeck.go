@@ -3,69 +3,226 @@ package ui
 import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/flow"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
 )
 
 // CardDeckView is a Widget for the card deck.
-// Position: (0,600,1280,120).
-// Displays up to 16 cards at 80x120.
+// Position: RegionCardDeck (0,600,1040,120).
+// Displays 12 cards at 80x120 and scrolls through the rest. The controls on the right filter, sort and page the cards.
+// Cards can be dragged to a position of the placed cards in BattleView and TerritoryView.
 type CardDeckView struct {
 	centerViewModer CenterViewModer
 	ViewModel       *viewmodel.CardDeckViewModel // ViewModel for display information
 	Flow            *flow.CardDeckFlow           // Flow for operations
 
+	// HoveredCardIndex and focusedCardIndex are indices of ViewModel, not of the visible slots.
 	HoveredCardIndex int
+	offset           int // Index of the first visible card
+
+	// Card focused by the keyboard or a gamepad
+	focusedCardIndex int
+	focusVisible     bool
+
+	drag *cardDrag
 }
 
 // NewCardDeckView creates a CardDeckView.
-func NewCardDeckView(centerViewModer CenterViewModer, viewModel *viewmodel.CardDeckViewModel, flow *flow.CardDeckFlow) *CardDeckView {
+func NewCardDeckView(centerViewModer CenterViewModer, viewModel *viewmodel.CardDeckViewModel, flow *flow.CardDeckFlow, drag *cardDrag) *CardDeckView {
 	return &CardDeckView{
 		centerViewModer: centerViewModer,
 		ViewModel:       viewModel,
 		Flow:            flow,
+		drag:            drag,
 	}
 }
 
 // HandleInput handles input for card selection and clicking.
 func (c *CardDeckView) HandleInput(input *Input) error {
-	cursorX, cursorY := input.Mouse.CursorPosition()
-	index := c.cardIndex(cursorX, cursorY)
+	c.ViewModel.Refresh()
 
-	if index == -1 {
-		c.HoveredCardIndex = -1
+	c.handleFocus(input)
+	if !c.drag.Active() && c.handleControls(input) {
 		return nil
 	}
 
-	c.HoveredCardIndex = index
+	cursorX, cursorY := input.Mouse.CursorPosition()
+	if _, wheelY := input.Mouse.Wheel(); wheelY != 0 && layoutRect(RegionCardDeck).Contains(cursorX, cursorY) {
+		if wheelY > 0 {
+			c.scroll(-1)
+		} else {
+			c.scroll(1)
+		}
+	}
 
+	index := c.cardIndex(cursorX, cursorY)
+	c.HoveredCardIndex = index
+	if index != -1 && input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) {
+		c.pressCard(index, cursorX, cursorY)
+	}
 	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
-		c.clickCard(index)
+		c.dropCard(index, cursorX, cursorY)
 	}
 
 	return nil
 }
 
+// pressCard starts dragging a card which can be played.
+func (c *CardDeckView) pressCard(idx, cursorX, cursorY int) {
+	cardID, ok := c.ViewModel.CardID(idx)
+	if !ok || !c.isPlayable(cardID) {
+		return
+	}
+	card, ok := c.ViewModel.Card(idx)
+	if !ok {
+		return
+	}
+	c.drag.Start(cardDragFromDeck, idx, card, cursorX, cursorY)
+}
+
+// dropCard finishes a click or a drag of a card. A click plays the card at the end, and a drop on the placed cards plays it there.
+func (c *CardDeckView) dropCard(hovered, cursorX, cursorY int) {
+	idx, dragged, ok := c.drag.Drop(cardDragFromDeck)
+	if !ok {
+		return
+	}
+	if !dragged {
+		if idx == hovered {
+			c.clickCard(idx)
+		}
+		return
+	}
+
+	at, ok := CardSlots{Rect: layoutRect(RegionPlacedCards)}.InsertIndexAt(cursorX, cursorY)
+	if !ok {
+		return
+	}
+	cardID, ok := c.ViewModel.CardID(idx)
+	if !ok || !c.isPlayable(cardID) {
+		return
+	}
+
+	switch c.centerViewModer.CurrentViewMode() {
+	case ViewTypeBattle:
+		c.Flow.PlayBattleCardInBattleAt(cardID, at)
+	case ViewTypeTerritory:
+		c.Flow.PlayStructureCardInTerritoryAt(cardID, at)
+	}
+}
+
+// handleFocus moves the focused card and plays it.
+func (c *CardDeckView) handleFocus(input *Input) {
+	c.focusVisible = input.FocusVisible()
+	length := c.ViewModel.NumCards()
+	if length == 0 {
+		c.focusedCardIndex = 0
+		return
+	}
+
+	switch {
+	case input.IsActionJustPressed(ActionNextCard):
+		c.focusedCardIndex = (c.focusedCardIndex + 1) % length
+	case input.IsActionJustPressed(ActionPrevCard):
+		c.focusedCardIndex = (c.focusedCardIndex + length - 1) % length
+	}
+	c.focusedCardIndex = min(c.focusedCardIndex, length-1)
+	c.scrollTo(c.focusedCardIndex)
+
+	if input.IsActionJustPressed(ActionPlayCard) {
+		c.clickCard(c.focusedCardIndex)
+	}
+}
+
+// handleControls handles the filter, sort and paging buttons. It returns true if one is clicked.
+func (c *CardDeckView) handleControls(input *Input) bool {
+	switch {
+	case c.filterButton().IsClicked(input):
+		c.ViewModel.CycleFilter(1)
+		c.offset = 0
+	case c.sortButton().IsClicked(input):
+		c.ViewModel.CycleSort(1)
+		c.offset = 0
+	case c.prevButton().IsClicked(input):
+		c.scroll(-c.numVisible())
+	case c.nextButton().IsClicked(input):
+		c.scroll(c.numVisible())
+	default:
+		return false
+	}
+	return true
+}
+
+func (c *CardDeckView) filterButton() Button {
+	r := layoutRect(RegionDeckControls).Cell(1, 4, 0)
+	return Button{Rect: r, Text: c.ViewModel.FilterText(), TextSize: 12, Color: buttonColorPaging}
+}
+
+func (c *CardDeckView) sortButton() Button {
+	r := layoutRect(RegionDeckControls).Cell(1, 4, 1)
+	return Button{Rect: r, Text: c.ViewModel.SortText(), TextSize: 12, Color: buttonColorPaging}
+}
+
+func (c *CardDeckView) prevButton() Button {
+	r := layoutRect(RegionDeckControls).Cell(2, 4, 4)
+	return Button{Rect: r, Text: "<", TextSize: 18, Color: buttonColorPaging}
+}
+
+func (c *CardDeckView) nextButton() Button {
+	r := layoutRect(RegionDeckControls).Cell(2, 4, 5)
+	return Button{Rect: r, Text: ">", TextSize: 18, Color: buttonColorPaging}
+}
+
+// numVisible returns the number of cards which fit in the strip.
+func (c *CardDeckView) numVisible() int {
+	return int(layoutRect(RegionDeckCards).Width) / cardWidth
+}
+
+// scroll moves the visible cards by delta, staying inside the deck.
+func (c *CardDeckView) scroll(delta int) {
+	maxOffset := max(c.ViewModel.NumCards()-c.numVisible(), 0)
+	c.offset = min(max(c.offset+delta, 0), maxOffset)
+}
+
+// scrollTo scrolls so that the card at idx is visible.
+func (c *CardDeckView) scrollTo(idx int) {
+	if idx < c.offset {
+		c.scroll(idx - c.offset)
+	}
+	if last := c.offset + c.numVisible() - 1; idx > last {
+		c.scroll(idx - last)
+	}
+}
+
 // cardIndex calculates which card index the cursor is over
 func (c *CardDeckView) cardIndex(cursorX, cursorY int) int {
-	// Card deck area: (0,600,1280,120)
-	if cursorY < 600 || cursorY >= 720 {
+	visible := min(c.ViewModel.NumCards()-c.offset, c.numVisible())
+	slot := c.cardSlots().IndexAt(cursorX, cursorY, visible)
+	if slot == -1 {
 		return -1
 	}
+	return c.offset + slot
+}
 
-	// Each card is 80px wide
-	idx := cursorX / 80
-	length := c.ViewModel.CountTypesInHand()
-	if idx >= length {
-		return -1
-	}
+func (c *CardDeckView) cardSlots() CardSlots {
+	return CardSlots{Rect: layoutRect(RegionDeckCards)}
+}
 
-	return idx
+// isPlayable returns true if the card can be played in the current MainView mode.
+func (c *CardDeckView) isPlayable(cardID core.CardID) bool {
+	switch c.centerViewModer.CurrentViewMode() {
+	case ViewTypeBattle:
+		return c.ViewModel.IsBattleCard(cardID)
+	case ViewTypeTerritory:
+		return c.ViewModel.IsStructureCard(cardID)
+	}
+	return false
 }
 
 func (c *CardDeckView) clickCard(idx int) {
 	cardID, ok := c.ViewModel.CardID(idx)
-	if !ok {
+	if !ok || !c.isPlayable(cardID) {
 		return
 	}
 
@@ -85,27 +242,61 @@ func (c *CardDeckView) clickStructureCard(cardID core.CardID) {
 	c.Flow.PlayStructureCardInTerritory(cardID)
 }
 
-// Draw draws all cards in the deck.
+// Draw draws the visible cards and the controls.
 func (c *CardDeckView) Draw(screen *ebiten.Image) {
-	length := c.ViewModel.CountTypesInHand()
-	for i := range length {
-		c.drawACard(screen, i)
+	c.ViewModel.Refresh()
+
+	slots := c.cardSlots()
+	length := c.ViewModel.NumCards()
+	visible := min(length-c.offset, c.numVisible())
+	card := func(i int) (*viewmodel.CardViewModel, bool) { return c.ViewModel.Card(c.offset + i) }
+	slots.Draw(screen, visible, 0, card, c.HoveredCardIndex-c.offset)
+
+	for i := range visible {
+		c.drawCardOverlay(screen, i)
+	}
+	if c.drag.Dragging(cardDragFromDeck) && c.drag.index >= c.offset && c.drag.index < c.offset+visible {
+		rect := slots.SlotRect(c.drag.index - c.offset)
+		drawing.DrawRect(screen, rect.X, rect.Y, rect.Width, rect.Height, 0, 0, 0, 0.5)
 	}
+
+	if c.focusVisible && c.focusedCardIndex >= c.offset && c.focusedCardIndex < c.offset+visible {
+		drawFocusFrame(screen, slots.SlotRect(c.focusedCardIndex-c.offset))
+	}
+
+	c.drawControls(screen)
 }
 
-func (c *CardDeckView) drawACard(screen *ebiten.Image, idx int) {
-	x, y := c.locationForCard(idx)
+// drawCardOverlay draws the number of copies of the card in the slot, and greys it out if it cannot be played.
+func (c *CardDeckView) drawCardOverlay(screen *ebiten.Image, slot int) {
+	idx := c.offset + slot
+	rect := c.cardSlots().SlotRect(slot)
 
-	card, ok := c.ViewModel.Card(idx)
-	if !ok {
-		DrawCardBackground(screen, float64(x), float64(y), 0.5)
-		return
+	if cardID, ok := c.ViewModel.CardID(idx); ok && !c.isPlayable(cardID) {
+		drawing.DrawRect(screen, rect.X, rect.Y, rect.Width, rect.Height, 0, 0, 0, 0.5)
 	}
 
-	DrawCard(screen, x, y, card, idx == c.HoveredCardIndex)
-
+	text := c.ViewModel.DuplicatesText(idx)
+	size := drawing.MeasureText(text, 16)
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(rect.X+rect.Width-size.X-6, rect.Y+6)
+	drawing.DrawText(screen, text, 16, opt)
 }
 
-func (c *CardDeckView) locationForCard(index int) (x, y float64) {
-	return float64(index) * 80, 600
+// drawControls draws the filter, sort and paging buttons, and the page number.
+func (c *CardDeckView) drawControls(screen *ebiten.Image) {
+	c.filterButton().Draw(screen)
+	c.sortButton().Draw(screen)
+	c.prevButton().Draw(screen)
+	c.nextButton().Draw(screen)
+
+	numVisible := c.numVisible()
+	pages := max((c.ViewModel.NumCards()+numVisible-1)/numVisible, 1)
+	page := min((c.offset+numVisible-1)/numVisible, pages-1) + 1
+	text := lang.ExecuteTemplate("ui-page", map[string]any{"page": page, "pages": pages})
+	r := layoutRect(RegionDeckControls).Cell(1, 4, 3)
+	size := drawing.MeasureText(text, 16)
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(r.X+(r.Width-size.X)/2, r.Y+6)
+	drawing.DrawText(screen, text, 16, opt)
 }
@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
+)
+
+// cardDragSource is where a dragged card comes from.
+type cardDragSource int
+
+const (
+	cardDragFromDeck   cardDragSource = iota // A card in CardDeckView
+	cardDragFromPlaced                       // A card placed in BattleView or TerritoryView
+)
+
+// dragThreshold is the distance in pixels the cursor moves before a press on a card becomes a drag.
+const dragThreshold = 6
+
+// cardDrag is the card pressed with the mouse. It is shared by CardDeckView and the views where cards are placed.
+// The view which starts the drag also handles the drop, so a release without moving is still a click.
+type cardDrag struct {
+	pressed  bool
+	dragging bool
+	dropped  bool // A dragged card was dropped in this frame
+	source   cardDragSource
+	index    int // Index of the card in the source
+	card     *viewmodel.CardViewModel
+
+	startX, startY int
+	x, y           int
+}
+
+// Update follows the cursor. It is called once a frame before the views handle input.
+func (d *cardDrag) Update(input *Input) {
+	d.dropped = false
+	if !d.pressed {
+		return
+	}
+
+	d.x, d.y = input.Mouse.CursorPosition()
+	dx, dy := d.x-d.startX, d.y-d.startY
+	if dx*dx+dy*dy > dragThreshold*dragThreshold {
+		d.dragging = true
+	}
+}
+
+// Start starts tracking the card pressed at (x, y).
+func (d *cardDrag) Start(source cardDragSource, index int, card *viewmodel.CardViewModel, x, y int) {
+	*d = cardDrag{
+		pressed: true,
+		source:  source,
+		index:   index,
+		card:    card,
+		startX:  x,
+		startY:  y,
+		x:       x,
+		y:       y,
+	}
+}
+
+// Drop ends the drag if it was started by source. It returns the index of the card and whether the card was dragged or just clicked.
+func (d *cardDrag) Drop(source cardDragSource) (index int, dragged bool, ok bool) {
+	if !d.pressed || d.source != source {
+		return 0, false, false
+	}
+	index, dragged = d.index, d.dragging
+	d.Cancel()
+	d.dropped = dragged
+	return index, dragged, true
+}
+
+// Cancel forgets the pressed card.
+func (d *cardDrag) Cancel() {
+	d.pressed = false
+	d.dragging = false
+	d.card = nil
+}
+
+// Active returns true while a card is dragged or has just been dropped. Buttons ignore the mouse then.
+func (d *cardDrag) Active() bool {
+	return d.dragging || d.dropped
+}
+
+// Dragging returns true if a card from source is being dragged.
+func (d *cardDrag) Dragging(source cardDragSource) bool {
+	return d.dragging && d.source == source
+}
+
+// Draw draws the dragged card under the cursor.
+func (d *cardDrag) Draw(screen *ebiten.Image) {
+	if !d.dragging || d.card == nil {
+		return
+	}
+	DrawCard(screen, float64(d.x-cardWidth/2), float64(d.y-cardHeight/2), d.card, true)
+}
+
+// drawPlaced darkens the dragged placed card and marks where the dragged card would be inserted in slots.
+// Cards from the deck are marked too, since only playable cards can be dragged.
+func (d *cardDrag) drawPlaced(screen *ebiten.Image, slots CardSlots, numCards int) {
+	if !d.dragging {
+		return
+	}
+
+	if d.source == cardDragFromPlaced {
+		rect := slots.SlotRect(d.index)
+		drawing.DrawRect(screen, rect.X, rect.Y, rect.Width, rect.Height, 0, 0, 0, 0.5)
+	}
+
+	at, ok := slots.InsertIndexAt(d.x, d.y)
+	if !ok {
+		return
+	}
+	rect := slots.SlotRect(min(at, numCards))
+	drawing.DrawRect(screen, rect.X-2, rect.Y, 4, rect.Height, 1, 0.85, 0.2, 1)
+}
+
+// placedCardDrop is what happens to a placed card when the mouse is released.
+type placedCardDrop int
+
+const (
+	placedCardKeep   placedCardDrop = iota
+	placedCardRemove                // Clicked, or dropped on the deck
+	placedCardMove                  // Dropped on another slot
+)
+
+// dropPlaced decides what happens to the placed card at from when the mouse is released at (x, y).
+// For placedCardMove, it also returns the index where the card ends up.
+func dropPlaced(slots CardSlots, numCards, from int, dragged bool, x, y int) (placedCardDrop, int) {
+	if !dragged {
+		if slots.IndexAt(x, y, numCards) == from {
+			return placedCardRemove, 0
+		}
+		return placedCardKeep, 0
+	}
+
+	if layoutRect(RegionCardDeck).Contains(x, y) {
+		return placedCardRemove, 0
+	}
+
+	at, ok := slots.InsertIndexAt(x, y)
+	if !ok {
+		return placedCardKeep, 0
+	}
+	// The card leaves its slot first, so the slots after it shift to the left.
+	if at > from {
+		at--
+	}
+	return placedCardMove, min(at, numCards-1)
+}
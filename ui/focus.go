@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
+)
+
+// focusTarget is an element which can be focused and activated without a mouse.
+type focusTarget struct {
+	rect     geom.Rect
+	activate func() (back bool)
+}
+
+// focusRing moves the focus between the targets of a view with the direction actions.
+type focusRing struct {
+	index   int
+	visible bool
+}
+
+// HandleInput moves the focus and activates the focused target on Confirm.
+func (f *focusRing) HandleInput(input *Input, targets []focusTarget) (back bool) {
+	f.visible = input.FocusVisible()
+	if len(targets) == 0 {
+		return false
+	}
+	f.index = min(max(f.index, 0), len(targets)-1)
+
+	if dx, dy, ok := input.Direction(); ok {
+		f.index = nextFocus(targets, f.index, dx, dy)
+		return false
+	}
+
+	if input.IsActionJustPressed(ActionConfirm) {
+		return targets[f.index].activate()
+	}
+	return false
+}
+
+// Draw draws a frame around the focused target while the focus is visible.
+func (f *focusRing) Draw(screen *ebiten.Image, targets []focusTarget) {
+	if !f.visible || f.index < 0 || f.index >= len(targets) {
+		return
+	}
+	drawFocusFrame(screen, targets[f.index].rect)
+}
+
+// Reset moves the focus back to the first target.
+func (f *focusRing) Reset() {
+	f.index = 0
+}
+
+// nextFocus returns the index of the nearest target in the direction (dx, dy) from the current one.
+// It returns current if there is no target in that direction.
+func nextFocus(targets []focusTarget, current, dx, dy int) int {
+	c := targets[current].rect.Center()
+	best := current
+	bestScore := 0.0
+	for i, t := range targets {
+		if i == current {
+			continue
+		}
+		d := t.rect.Center().Subtract(c)
+		along := d.X*float64(dx) + d.Y*float64(dy)
+		if along <= 0 {
+			continue
+		}
+		across := math.Abs(d.X*float64(dy) + d.Y*float64(dx))
+		// Targets off the axis are far away, so the focus moves straight if possible.
+		score := along + across*2
+		if best == current || score < bestScore {
+			best = i
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// drawFocusFrame draws a frame marking the focused element.
+func drawFocusFrame(screen *ebiten.Image, r geom.Rect) {
+	const thickness = 3
+	drawing.DrawRect(screen, r.X, r.Y, r.Width, thickness, 1, 0.85, 0.2, 1)
+	drawing.DrawRect(screen, r.X, r.Y+r.Height-thickness, r.Width, thickness, 1, 0.85, 0.2, 1)
+	drawing.DrawRect(screen, r.X, r.Y, thickness, r.Height, 1, 0.85, 0.2, 1)
+	drawing.DrawRect(screen, r.X+r.Width-thickness, r.Y, thickness, r.Height, 1, 0.85, 0.2, 1)
+}
@@ -2,7 +2,6 @@ package ui
 
 import (
 	"math/rand"
-	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
@@ -35,6 +34,9 @@ type GameUI struct {
 
 	// Mouse position tracking
 	MouseX, MouseY int
+
+	// Card dragged between CardDeckView and MainView
+	drag *cardDrag
 }
 
 // NewGameUI creates a GameUI.
@@ -52,10 +54,11 @@ func NewGameUI(gameState *core.GameState) *GameUI {
 	// Initialize each Widget with viewmodels
 	resourceView := NewResourceView(resourceViewModel)
 	calendarView := NewCalendarView(calendarViewModel)
-	mainView := NewMainView(gameState, rand.New(rand.NewSource(time.Now().UnixNano())))
-	infoView := NewInfoView(viewmodel.NewHistoryViewModel(gameState))
+	drag := &cardDrag{}
+	mainView := NewMainView(gameState, rand.New(rand.NewSource(gameState.Seed)), drag)
+	infoView := NewInfoView(viewmodel.NewHistoryViewModel(gameState), NewMinimap(mainView, mainView.MapGrid))
 
-	cardDeckView := NewCardDeckView(mainView, cardDeckViewModel, cardDeckFlow)
+	cardDeckView := NewCardDeckView(mainView, cardDeckViewModel, cardDeckFlow, drag)
 
 	ui := &GameUI{
 		ResourceView: resourceView,
@@ -72,6 +75,8 @@ func NewGameUI(gameState *core.GameState) *GameUI {
 		CalendarViewModel: calendarViewModel,
 		CardDeckViewModel: cardDeckViewModel,
 		MapGridViewModel:  mapGridViewModel,
+
+		drag: drag,
 	}
 
 	return ui
@@ -81,6 +86,7 @@ func NewGameUI(gameState *core.GameState) *GameUI {
 func (gui *GameUI) HandleInput(input *Input) error {
 	// Update mouse position
 	gui.MouseX, gui.MouseY = input.Mouse.CursorPosition()
+	gui.drag.Update(input)
 
 	// MainView processes input first (important processes such as View switching).
 	if err := gui.MainView.HandleInput(input); err != nil {
@@ -92,8 +98,21 @@ func (gui *GameUI) HandleInput(input *Input) error {
 		return err
 	}
 
+	// A drag released where no view takes it is cancelled.
+	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
+		gui.drag.Cancel()
+	}
+	if gui.drag.Active() {
+		return nil
+	}
+
+	// Handle input for InfoView (history filters and paging)
+	if err := gui.InfoView.HandleInput(input); err != nil {
+		return err
+	}
+
 	// Other Widgets are for display only and do not handle input.
-	// ResourceView, CalendarView, and InfoView are basically for display only.
+	// ResourceView and CalendarView are for display only.
 
 	return nil
 }
@@ -121,6 +140,9 @@ func (gui *GameUI) Draw(screen *ebiten.Image) {
 
 	// 5. CardDeckView (bottom card deck)
 	gui.CardDeckView.Draw(screen)
+
+	// 6. The dragged card over everything
+	gui.drag.Draw(screen)
 }
 
 // GetCurrentMainViewType gets the current MainViewType.
@@ -20,27 +20,98 @@ const (
 )
 
 // InfoView is a widget for displaying information.
-// Position: (520,20,120,280).
+// Position: RegionInfo (1040,40,240,680).
 // Changes the content of the information displayed according to the situation.
 type InfoView struct {
 	CurrentMode InfoViewMode
 	viewModel   *viewmodel.HistoryViewModel
+	page        int      // page is the history page counted from the latest one.
+	minimap     *Minimap // minimap is drawn below the history on the map. It can be nil.
 }
 
+const (
+	historyPageSize        = 7
+	historyMinimapPageSize = 5 // historyMinimapPageSize is the page size while the minimap takes the bottom of the view.
+	historyFilterWidth     = 46
+	historyFirstEntryY     = 104
+	historyEntrySpacing    = 80
+)
+
 // NewInfoView creates an InfoView.
-func NewInfoView(viewModel *viewmodel.HistoryViewModel) *InfoView {
+func NewInfoView(viewModel *viewmodel.HistoryViewModel, minimap *Minimap) *InfoView {
 	return &InfoView{
 		CurrentMode: InfoModeHistory, // The default is HistoryView.
 		viewModel:   viewModel,
+		minimap:     minimap,
 	}
 }
 
 // HandleInput handles input.
+// Only the HistoryView accepts input, to select the filter and the page.
 func (iv *InfoView) HandleInput(input *Input) error {
-	// InfoView basically does not accept input (display only).
+	if iv.CurrentMode != InfoModeHistory {
+		return nil
+	}
+	// The page size changes when the minimap appears or disappears.
+	iv.page = min(iv.page, iv.numPages()-1)
+	if iv.minimap != nil && iv.minimap.HandleInput(input) {
+		return nil
+	}
+
+	for i, filter := range viewmodel.HistoryFilters {
+		if iv.filterButton(i).IsClicked(input) {
+			iv.viewModel.SetFilter(filter)
+			iv.page = 0
+			return nil
+		}
+	}
+
+	if iv.olderButton().IsClicked(input) && iv.page < iv.numPages()-1 {
+		iv.page++
+	}
+	if iv.newerButton().IsClicked(input) && iv.page > 0 {
+		iv.page--
+	}
 	return nil
 }
 
+// filterButton returns the button of the i-th history filter.
+func (iv *InfoView) filterButton(i int) Button {
+	filter := viewmodel.HistoryFilters[i]
+	button := Button{
+		Rect:     layoutRect(RegionHistoryFilters).Row(i, historyFilterWidth, 24, 1),
+		Text:     iv.viewModel.FilterText(filter),
+		TextSize: 12,
+		Color:    buttonColorPaging,
+	}
+	if filter == iv.viewModel.Filter() {
+		button.Color = buttonColorSelected
+	}
+	return button
+}
+
+func (iv *InfoView) olderButton() Button {
+	return Button{Rect: layoutRect(RegionHistoryOlder), Text: "<", TextSize: 18, Color: buttonColorPaging}
+}
+
+func (iv *InfoView) newerButton() Button {
+	return Button{Rect: layoutRect(RegionHistoryNewer), Text: ">", TextSize: 18, Color: buttonColorPaging}
+}
+
+// pageSize returns the number of history events in a page.
+func (iv *InfoView) pageSize() int {
+	if iv.minimap != nil && iv.minimap.Visible() {
+		return historyMinimapPageSize
+	}
+	return historyPageSize
+}
+
+// numPages returns the number of history pages. It is at least 1.
+func (iv *InfoView) numPages() int {
+	pageSize := iv.pageSize()
+	return max((iv.viewModel.HistoryLen()+pageSize-1)/pageSize, 1)
+}
+
 // Draw handles drawing.
 func (iv *InfoView) Draw(screen *ebiten.Image) {
 	// Draw background.
@@ -50,6 +121,9 @@ func (iv *InfoView) Draw(screen *ebiten.Image) {
 	switch iv.CurrentMode {
 	case InfoModeHistory:
 		iv.drawHistoryView(screen)
+		if iv.minimap != nil {
+			iv.minimap.Draw(screen)
+		}
 	case InfoModeCardInfo:
 		iv.drawCardInfoView(screen)
 	case InfoModeNationPoint:
@@ -63,60 +137,72 @@ func (iv *InfoView) Draw(screen *ebiten.Image) {
 
 // drawBackground draws the background.
 func (iv *InfoView) drawBackground(screen *ebiten.Image) {
-	// InfoView background (1040,40,240,680).
-	vertices := []ebiten.Vertex{
-		{DstX: 1040, DstY: 40, SrcX: 0, SrcY: 0, ColorR: 0.15, ColorG: 0.15, ColorB: 0.2, ColorA: 1},
-		{DstX: 1280, DstY: 40, SrcX: 0, SrcY: 0, ColorR: 0.15, ColorG: 0.15, ColorB: 0.2, ColorA: 1},
-		{DstX: 1280, DstY: 720, SrcX: 0, SrcY: 0, ColorR: 0.15, ColorG: 0.15, ColorB: 0.2, ColorA: 1},
-		{DstX: 1040, DstY: 720, SrcX: 0, SrcY: 0, ColorR: 0.15, ColorG: 0.15, ColorB: 0.2, ColorA: 1},
-	}
-	indices := []uint16{0, 1, 2, 0, 2, 3}
-	screen.DrawTriangles(vertices, indices, drawing.WhitePixel, &ebiten.DrawTrianglesOptions{})
+	r := layoutRect(RegionInfo)
+	drawing.DrawRect(screen, r.X, r.Y, r.Width, r.Height, 0.15, 0.15, 0.2, 1)
 }
 
 // drawHistoryView draws the HistoryView.
 func (iv *InfoView) drawHistoryView(screen *ebiten.Image) {
+	info := layoutRect(RegionInfo)
+
 	// Title.
 	opt := &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(1050, 40)
+	opt.GeoM.Translate(info.X+10, info.Y)
 	drawing.DrawText(screen, lang.Text("ui-history"), 24, opt)
 
+	iv.drawHistoryFilters(screen)
+
 	historyLen := iv.viewModel.HistoryLen()
 
 	// Display when there is no history.
 	if historyLen == 0 {
 		opt = &ebiten.DrawImageOptions{}
-		opt.GeoM.Translate(1050, 80)
+		opt.GeoM.Translate(info.X+10, historyFirstEntryY)
 		drawing.DrawText(screen, lang.Text("ui-no-events"), 18, opt)
 		return
 	}
 
-	// Display history events.
-	historyDelta := 0
-	if historyLen > 7 {
-		historyDelta = historyLen - 7
-		historyLen = 7
-	}
+	// Display the history events of the page, the oldest first.
+	pageSize := iv.pageSize()
+	end := historyLen - iv.page*pageSize
+	start := max(end-pageSize, 0)
 
-	for i := range historyLen {
-		historyIdx := i + historyDelta
+	for i := range end - start {
+		historyIdx := start + i
 		dateText := iv.viewModel.HistoryDateText(historyIdx)
 		eventText := iv.viewModel.HistoryEventText(historyIdx)
 
+		y := float64(historyFirstEntryY + i*historyEntrySpacing)
 		opt = &ebiten.DrawImageOptions{}
-		opt.GeoM.Translate(1050, 80.0+float64(i)*70)
-		drawing.DrawText(screen, dateText, 18, opt)
-
-		y := 80.0 + float64(i)*70
-		opt = &ebiten.DrawImageOptions{}
-		opt.GeoM.Translate(1050, y)
+		opt.GeoM.Translate(info.X+10, y)
 		drawing.DrawText(screen, dateText, 18, opt)
 
 		y += 24
 		opt = &ebiten.DrawImageOptions{}
-		opt.GeoM.Translate(1050, y)
+		opt.GeoM.Translate(info.X+10, y)
 		drawing.DrawText(screen, eventText, 16, opt)
 	}
+
+	iv.drawHistoryPaging(screen)
+}
+
+// drawHistoryFilters draws the filter buttons.
+func (iv *InfoView) drawHistoryFilters(screen *ebiten.Image) {
+	for i := range viewmodel.HistoryFilters {
+		iv.filterButton(i).Draw(screen)
+	}
+}
+
+// drawHistoryPaging draws the paging buttons and the page number between them.
+func (iv *InfoView) drawHistoryPaging(screen *ebiten.Image) {
+	older := iv.olderButton()
+	older.Draw(screen)
+	iv.newerButton().Draw(screen)
+
+	// Page 1 is the latest one.
+	opt := &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(older.Rect.X+80, older.Rect.Y+6)
+	drawing.DrawText(screen, lang.ExecuteTemplate("ui-page", map[string]any{"page": iv.page + 1, "pages": iv.numPages()}), 16, opt)
 }
 
 // drawCardInfoView draws the CardInfoView.
@@ -1,7 +1,192 @@
 package ui
 
-import "github.com/noppikinatta/nyuuryoku"
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/nyuuryoku"
+)
 
+// Action is a game command which is independent of the physical input device.
+type Action int
+
+const (
+	ActionConfirm     Action = iota // Activates the focused button or item
+	ActionBack                      // Closes the current view. Keyboard and gamepad only, so a stray click never cancels a battle
+	ActionNextCard                  // Moves the card deck focus to the right
+	ActionPrevCard                  // Moves the card deck focus to the left
+	ActionPlayCard                  // Plays the focused card of the card deck
+	ActionProceed                   // Moves the current view forward: opens the market of my nation, or fights the battle or commits the construction
+	ActionSelectPoint               // Opens the focused point of the map grid
+	ActionUp
+	ActionDown
+	ActionLeft
+	ActionRight
+	ActionZoomIn        // Zooms in the map grid
+	ActionZoomOut       // Zooms out the map grid
+	ActionToggleMinimap // Shows or hides the minimap of a map grid larger than the screen
+)
+
+// Binding is the set of mouse buttons, keys and gamepad buttons that trigger an Action.
+type Binding struct {
+	MouseButtons   []ebiten.MouseButton
+	Keys           []ebiten.Key
+	GamepadButtons []ebiten.StandardGamepadButton
+}
+
+// DefaultBindings returns the bindings used unless the player changes them.
+func DefaultBindings() map[Action]Binding {
+	return map[Action]Binding{
+		ActionConfirm: {
+			Keys:           []ebiten.Key{ebiten.KeyEnter, ebiten.KeySpace},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonRightBottom},
+		},
+		ActionBack: {
+			Keys:           []ebiten.Key{ebiten.KeyEscape, ebiten.KeyBackspace},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonRightRight},
+		},
+		ActionNextCard: {
+			Keys:           []ebiten.Key{ebiten.KeyE, ebiten.KeyTab},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonFrontTopRight},
+		},
+		ActionPrevCard: {
+			Keys:           []ebiten.Key{ebiten.KeyQ},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonFrontTopLeft},
+		},
+		ActionPlayCard: {
+			Keys:           []ebiten.Key{ebiten.KeyF},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonRightLeft},
+		},
+		ActionProceed: {
+			Keys:           []ebiten.Key{ebiten.KeyT},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonCenterRight},
+		},
+		ActionSelectPoint: {
+			Keys:           []ebiten.Key{ebiten.KeyEnter, ebiten.KeySpace},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonRightBottom},
+		},
+		ActionUp: {
+			Keys:           []ebiten.Key{ebiten.KeyArrowUp, ebiten.KeyW},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftTop},
+		},
+		ActionDown: {
+			Keys:           []ebiten.Key{ebiten.KeyArrowDown, ebiten.KeyS},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftBottom},
+		},
+		ActionLeft: {
+			Keys:           []ebiten.Key{ebiten.KeyArrowLeft, ebiten.KeyA},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftLeft},
+		},
+		ActionRight: {
+			Keys:           []ebiten.Key{ebiten.KeyArrowRight, ebiten.KeyD},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftRight},
+		},
+		ActionZoomIn: {
+			Keys:           []ebiten.Key{ebiten.KeyEqual, ebiten.KeyNumpadAdd},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonFrontBottomRight},
+		},
+		ActionZoomOut: {
+			Keys:           []ebiten.Key{ebiten.KeyMinus, ebiten.KeyNumpadSubtract},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonFrontBottomLeft},
+		},
+		ActionToggleMinimap: {
+			Keys:           []ebiten.Key{ebiten.KeyM},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonCenterLeft},
+		},
+	}
+}
+
+// Input holds the input devices and the bindings of actions to them.
 type Input struct {
-	Mouse *nyuuryoku.Mouse
+	Mouse    *nyuuryoku.Mouse
+	Keyboard *nyuuryoku.Keyboard
+	Gamepad  *nyuuryoku.Gamepad
+	Bindings map[Action]Binding
+
+	gamepadIDs       []ebiten.GamepadID
+	focusVisible     bool
+	cursorX, cursorY int
+}
+
+// NewInput creates an Input reading all devices with the default bindings.
+func NewInput() *Input {
+	return &Input{
+		Mouse:    nyuuryoku.NewMouse(),
+		Keyboard: nyuuryoku.NewKeyboard(),
+		Gamepad:  nyuuryoku.NewGamepad(),
+		Bindings: DefaultBindings(),
+	}
+}
+
+// Update polls the connected gamepads and tracks which device was used last. It must be called once per frame before the scenes handle input.
+func (i *Input) Update() {
+	if i.Gamepad != nil {
+		i.gamepadIDs = i.Gamepad.AppendIDs(i.gamepadIDs[:0])
+	}
+
+	x, y := i.Mouse.CursorPosition()
+	if x != i.cursorX || y != i.cursorY || i.Mouse.IsJustPressed(ebiten.MouseButtonLeft) {
+		i.focusVisible = false
+	}
+	i.cursorX, i.cursorY = x, y
+
+	for action := range i.Bindings {
+		if i.isKeyOrButtonJustPressed(action) {
+			i.focusVisible = true
+			return
+		}
+	}
+}
+
+// FocusVisible returns true while the player uses the keyboard or a gamepad, so views should highlight the focused element.
+func (i *Input) FocusVisible() bool {
+	return i.focusVisible
+}
+
+// IsActionJustPressed returns true if any input bound to the action is pressed in this frame.
+func (i *Input) IsActionJustPressed(action Action) bool {
+	binding, ok := i.Bindings[action]
+	if !ok {
+		return false
+	}
+	for _, b := range binding.MouseButtons {
+		if i.Mouse.IsJustPressed(b) {
+			return true
+		}
+	}
+	return i.isKeyOrButtonJustPressed(action)
+}
+
+func (i *Input) isKeyOrButtonJustPressed(action Action) bool {
+	binding := i.Bindings[action]
+	if i.Keyboard != nil {
+		for _, k := range binding.Keys {
+			if i.Keyboard.IsJustPressed(k) {
+				return true
+			}
+		}
+	}
+	if i.Gamepad != nil {
+		for _, id := range i.gamepadIDs {
+			for _, b := range binding.GamepadButtons {
+				if i.Gamepad.IsStandardButtonJustPressed(id, b) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Direction returns the direction of the just pressed direction action, if any.
+func (i *Input) Direction() (dx, dy int, ok bool) {
+	switch {
+	case i.IsActionJustPressed(ActionUp):
+		return 0, -1, true
+	case i.IsActionJustPressed(ActionDown):
+		return 0, 1, true
+	case i.IsActionJustPressed(ActionLeft):
+		return -1, 0, true
+	case i.IsActionJustPressed(ActionRight):
+		return 1, 0, true
+	}
+	return 0, 0, false
 }
@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
+)
+
+// RegionName is the name of a region of the screen layout.
+type RegionName string
+
+const (
+	RegionScreen   RegionName = "screen"
+	RegionResource RegionName = "resource"
+	RegionCalendar RegionName = "calendar"
+	RegionCardDeck RegionName = "card-deck"
+
+	// Regions of CardDeckView
+	RegionDeckCards    RegionName = "deck-cards"
+	RegionDeckControls RegionName = "deck-controls" // Filter, sort and paging buttons
+
+	// Regions of MainView, shared by its child views
+	RegionMain        RegionName = "main"
+	RegionMapGrid     RegionName = "map-grid"
+	RegionMainHeader  RegionName = "main-header"
+	RegionMainBack    RegionName = "main-back"
+	RegionMainAction  RegionName = "main-action" // Conquer or confirm button
+	RegionMarketItems RegionName = "market-items"
+	RegionPlacedCards RegionName = "placed-cards"
+	RegionTerrain     RegionName = "terrain-upgrade"
+	RegionLevelUp     RegionName = "level-up"
+
+	// Regions of the battle result
+	RegionSurvivors RegionName = "survivors"
+	RegionLosses    RegionName = "losses"
+
+	// Regions of InfoView
+	RegionInfo           RegionName = "info"
+	RegionHistoryFilters RegionName = "history-filters"
+	RegionHistoryOlder   RegionName = "history-older"
+	RegionHistoryNewer   RegionName = "history-newer"
+	RegionMinimap        RegionName = "minimap"
+)
+
+// Region is a named rectangle of the screen layout. The rectangles of the children are inside the parent, in screen coordinates.
+type Region struct {
+	Name     RegionName
+	Rect     geom.Rect
+	Children []*Region
+}
+
+// Find returns the region with the name in the tree.
+func (r *Region) Find(name RegionName) (*Region, bool) {
+	if r.Name == name {
+		return r, true
+	}
+	for _, child := range r.Children {
+		if found, ok := child.Find(name); ok {
+			return found, true
+		}
+	}
+	return nil, false
+}
+
+// Validate returns an error if a child is outside its parent or a name is used twice.
+func (r *Region) Validate() error {
+	return r.validate(map[RegionName]bool{})
+}
+
+func (r *Region) validate(names map[RegionName]bool) error {
+	if names[r.Name] {
+		return fmt.Errorf("ui: duplicated region %q", r.Name)
+	}
+	names[r.Name] = true
+
+	for _, child := range r.Children {
+		if !r.Rect.ContainsRect(child.Rect) {
+			return fmt.Errorf("ui: region %q %v is outside %q %v", child.Name, child.Rect, r.Name, r.Rect)
+		}
+		if err := child.validate(names); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScreenLayout is the layout of the in-game screen (1280x720).
+var ScreenLayout = &Region{
+	Name: RegionScreen,
+	Rect: geom.Rect{X: 0, Y: 0, Width: 1280, Height: 720},
+	Children: []*Region{
+		{Name: RegionResource, Rect: geom.Rect{X: 0, Y: 0, Width: 600, Height: 40}},
+		{Name: RegionCalendar, Rect: geom.Rect{X: 1040, Y: 0, Width: 240, Height: 40}},
+		{
+			Name: RegionMain,
+			Rect: geom.Rect{X: 0, Y: 40, Width: 1040, Height: 560},
+			Children: []*Region{
+				{Name: RegionMapGrid, Rect: geom.Rect{X: 0, Y: 40, Width: 1040, Height: 560}},
+				{Name: RegionMainHeader, Rect: geom.Rect{X: 0, Y: 40, Width: 960, Height: 80}},
+				{Name: RegionMainBack, Rect: geom.Rect{X: 960, Y: 40, Width: 80, Height: 80}},
+				{Name: RegionMarketItems, Rect: geom.Rect{X: 0, Y: 120, Width: 1040, Height: 480}},
+				{Name: RegionSurvivors, Rect: geom.Rect{X: 100, Y: 160, Width: 800, Height: 120}},
+				{Name: RegionLosses, Rect: geom.Rect{X: 100, Y: 340, Width: 800, Height: 120}},
+				{Name: RegionPlacedCards, Rect: geom.Rect{X: 100, Y: 400, Width: 800, Height: 120}},
+				{Name: RegionTerrain, Rect: geom.Rect{X: 680, Y: 500, Width: 240, Height: 40}},
+				{Name: RegionMainAction, Rect: geom.Rect{X: 400, Y: 560, Width: 240, Height: 40}},
+				{Name: RegionLevelUp, Rect: geom.Rect{X: 680, Y: 560, Width: 240, Height: 40}},
+			},
+		},
+		{
+			Name: RegionInfo,
+			Rect: geom.Rect{X: 1040, Y: 40, Width: 240, Height: 680},
+			Children: []*Region{
+				{Name: RegionHistoryFilters, Rect: geom.Rect{X: 1044, Y: 72, Width: 235, Height: 24}},
+				{Name: RegionHistoryOlder, Rect: geom.Rect{X: 1050, Y: 680, Width: 40, Height: 30}},
+				{Name: RegionHistoryNewer, Rect: geom.Rect{X: 1230, Y: 680, Width: 40, Height: 30}},
+				{Name: RegionMinimap, Rect: geom.Rect{X: 1050, Y: 490, Width: 220, Height: 180}},
+			},
+		},
+		{
+			Name: RegionCardDeck,
+			Rect: geom.Rect{X: 0, Y: 600, Width: 1040, Height: 120},
+			Children: []*Region{
+				{Name: RegionDeckCards, Rect: geom.Rect{X: 0, Y: 600, Width: 960, Height: 120}},
+				{Name: RegionDeckControls, Rect: geom.Rect{X: 960, Y: 600, Width: 80, Height: 120}},
+			},
+		},
+	},
+}
+
+// regionRects is the flattened ScreenLayout.
+var regionRects = map[RegionName]geom.Rect{}
+
+func init() {
+	if err := ScreenLayout.Validate(); err != nil {
+		panic(err)
+	}
+	var add func(r *Region)
+	add = func(r *Region) {
+		regionRects[r.Name] = r.Rect
+		for _, child := range r.Children {
+			add(child)
+		}
+	}
+	add(ScreenLayout)
+}
+
+// layoutRect returns the rectangle of the region. An unknown name is a programming error, so it panics.
+func layoutRect(name RegionName) geom.Rect {
+	r, ok := regionRects[name]
+	if !ok {
+		panic(fmt.Sprintf("ui: unknown region %q", name))
+	}
+	return r
+}
@@ -22,7 +22,7 @@ type CenterViewModer interface {
 }
 
 // MainView is the main view container Widget.
-// Position: (0,40,1040,560).
+// Position: RegionMain (0,40,1040,560).
 // Switches between MapGridView, MarketView, BattleView, and TerritoryView.
 type MainView struct {
 	CurrentView ViewType
@@ -36,7 +36,8 @@ type MainView struct {
 }
 
 // NewMainView creates a MainView.
-func NewMainView(gameState *core.GameState, intner core.Intner) *MainView {
+// drag is shared with CardDeckView, so cards can be dragged between the deck and the placed cards.
+func NewMainView(gameState *core.GameState, intner core.Intner, drag *cardDrag) *MainView {
 	m := &MainView{
 		CurrentView: ViewTypeMapGrid, // The initial display is MapGridView.
 		GameState:   gameState,
@@ -44,8 +45,8 @@ func NewMainView(gameState *core.GameState, intner core.Intner) *MainView {
 
 	// Construct child views
 	m.Market = NewMarketView(flow.NewMarketFlow(gameState, intner), viewmodel.NewMarketViewModel(gameState))
-	m.Battle = NewBattleView(flow.NewBattleFlow(gameState))
-	m.Territory = NewTerritoryView(flow.NewTerritoryFlow(gameState))
+	m.Battle = NewBattleView(flow.NewBattleFlow(gameState, intner), drag)
+	m.Territory = NewTerritoryView(flow.NewTerritoryFlow(gameState), drag)
 
 	// No direct GameState injection to views; views use flow/viewmodel
 
@@ -105,6 +106,11 @@ func (m *MainView) CurrentViewMode() ViewType {
 
 // HandleInput handles input.
 func (m *MainView) HandleInput(input *Input) error {
+	if m.CurrentView == ViewTypeMapGrid && input.IsActionJustPressed(ActionProceed) {
+		m.openMyNationMarket()
+		return nil
+	}
+
 	shouldBackToMapGrid, err := m.handleChildren(input)
 	if err != nil {
 		return err
@@ -117,6 +123,20 @@ func (m *MainView) HandleInput(input *Input) error {
 	return nil
 }
 
+// openMyNationMarket opens the market of my nation, where purchasing a card pack ends the turn.
+func (m *MainView) openMyNationMarket() {
+	for i, point := range m.GameState.MapGrid.Points {
+		if _, ok := point.(*core.MyNationPoint); !ok {
+			continue
+		}
+		x, y, ok := m.GameState.MapGrid.XYFromIndex(i)
+		if ok {
+			m.MapGrid.selectPoint(x, y)
+		}
+		return
+	}
+}
+
 func (m *MainView) handleChildren(input *Input) (bool, error) {
 	switch m.CurrentView {
 	case ViewTypeMapGrid:
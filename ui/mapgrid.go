@@ -1,68 +1,181 @@
 package ui
 
 import (
-	"fmt"
+	"image"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/flow"
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
 )
 
 // MapGridView is a Widget for displaying the MapGrid.
-// Position: Drawn within MainView
+// Position: RegionMapGrid (0,40,1040,560).
+// The grid is seen through a camera, which is panned by dragging or by moving the focus, and zoomed by the mouse wheel.
 type MapGridView struct {
 	ViewModel *viewmodel.MapGridViewModel
 	Flow      *flow.MapGridFlow
 
 	// Callbacks
 	OnPointClicked func(point core.Point)
+
+	// Grid coordinates focused by the keyboard or a gamepad
+	focusX, focusY int
+	focusVisible   bool
+
+	camera *geom.Camera
+
+	// Mouse drag panning the camera. A press which does not move far is a click.
+	pressed          bool
+	panning          bool
+	pressX, pressY   int
+	cursorX, cursorY int
 }
 
+const (
+	mapMaxZoom  = 2.0
+	mapZoomStep = 1.25
+)
+
 // NewMapGridView creates a MapGridView
 func NewMapGridView(viewModel *viewmodel.MapGridViewModel, flow *flow.MapGridFlow, onPointClicked func(point core.Point)) *MapGridView {
-	return &MapGridView{
+	m := &MapGridView{
 		ViewModel:      viewModel,
 		Flow:           flow,
 		OnPointClicked: onPointClicked,
 	}
+	m.camera = geom.NewCamera(layoutRect(RegionMapGrid), m.worldRect(), mapMaxZoom)
+	return m
+}
+
+// worldRect returns the area of the whole grid in world coordinates.
+func (m *MapGridView) worldRect() geom.Rect {
+	size := m.ViewModel.Size()
+	return geom.Rect{Width: float64(size.X * mapCellSize), Height: float64(size.Y * mapCellSize)}
+}
+
+// Camera returns the camera of the map grid, shared with the minimap.
+func (m *MapGridView) Camera() *geom.Camera {
+	m.camera.SetWorld(m.worldRect())
+	return m.camera
 }
 
 // HandleInput processes input
 func (m *MapGridView) HandleInput(input *Input) error {
-	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
-		cursorX, cursorY := input.Mouse.CursorPosition()
-
-		// Calculate grid coordinates from cursor position
-		x, y := m.getGridCoordinates(cursorX, cursorY)
-		if x >= 0 && y >= 0 {
-			// Select point using flow
-			if m.Flow.SelectPoint(x, y) {
-				// Get selected point and notify callback
-				selectedPoint := m.Flow.GetSelectedPoint()
-				if selectedPoint != nil && m.OnPointClicked != nil {
-					m.OnPointClicked(selectedPoint)
-				}
-			}
-		}
+	m.focusVisible = input.FocusVisible()
+	camera := m.Camera()
+
+	if dx, dy, ok := input.Direction(); ok {
+		m.moveFocus(dx, dy)
+		return nil
+	}
+
+	if input.IsActionJustPressed(ActionSelectPoint) {
+		m.selectPoint(m.focusX, m.focusY)
+		return nil
 	}
+
+	viewport := layoutRect(RegionMapGrid)
+	switch {
+	case input.IsActionJustPressed(ActionZoomIn):
+		camera.ZoomAt(viewport.Center(), mapZoomStep)
+	case input.IsActionJustPressed(ActionZoomOut):
+		camera.ZoomAt(viewport.Center(), 1/mapZoomStep)
+	}
+
+	cursorX, cursorY := input.Mouse.CursorPosition()
+	if _, wheelY := input.Mouse.Wheel(); wheelY != 0 && viewport.Contains(cursorX, cursorY) {
+		camera.ZoomAt(geom.PointF{X: float64(cursorX), Y: float64(cursorY)}, math.Pow(mapZoomStep, wheelY))
+	}
+
+	m.handleDrag(input, cursorX, cursorY)
 	return nil
 }
 
+// handleDrag pans the camera while the left button is dragged, and selects the point on a click.
+func (m *MapGridView) handleDrag(input *Input, cursorX, cursorY int) {
+	if input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) && layoutRect(RegionMapGrid).Contains(cursorX, cursorY) {
+		m.pressed, m.panning = true, false
+		m.pressX, m.pressY = cursorX, cursorY
+		m.cursorX, m.cursorY = cursorX, cursorY
+	}
+	if !m.pressed {
+		return
+	}
+
+	if dx, dy := cursorX-m.pressX, cursorY-m.pressY; dx*dx+dy*dy > dragThreshold*dragThreshold {
+		m.panning = true
+	}
+	if m.panning {
+		m.camera.Pan(float64(cursorX-m.cursorX), float64(cursorY-m.cursorY))
+	}
+	m.cursorX, m.cursorY = cursorX, cursorY
+
+	if !input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
+		return
+	}
+	m.pressed = false
+	if m.panning {
+		return
+	}
+
+	// Calculate grid coordinates from cursor position
+	x, y := m.getGridCoordinates(cursorX, cursorY)
+	if x >= 0 && y >= 0 {
+		m.focusX, m.focusY = x, y
+		m.selectPoint(x, y)
+	}
+}
+
+// moveFocus moves the focused grid coordinates, staying inside the grid, and pans the camera to show it.
+func (m *MapGridView) moveFocus(dx, dy int) {
+	size := m.ViewModel.Size()
+	m.focusX = min(max(m.focusX+dx, 0), size.X-1)
+	m.focusY = min(max(m.focusY+dy, 0), size.Y-1)
+	m.camera.Reveal(worldCellRect(m.focusX, m.focusY))
+}
+
+// selectPoint selects the point using flow and notifies the callback.
+func (m *MapGridView) selectPoint(x, y int) {
+	if !m.Flow.SelectPoint(x, y) {
+		return
+	}
+	selectedPoint := m.Flow.GetSelectedPoint()
+	if selectedPoint != nil && m.OnPointClicked != nil {
+		m.OnPointClicked(selectedPoint)
+	}
+}
+
+// mapCellSize is the width and height of a grid cell in world coordinates, which are pixels at zoom 1.
+const mapCellSize = 100
+
+// worldCellRect returns the area of the grid cell (x, y) in world coordinates.
+func worldCellRect(x, y int) geom.Rect {
+	return geom.Rect{X: float64(x * mapCellSize), Y: float64(y * mapCellSize), Width: mapCellSize, Height: mapCellSize}
+}
+
+// cellRect returns the area of the grid cell (x, y) on the screen.
+func (m *MapGridView) cellRect(x, y int) geom.Rect {
+	return m.camera.RectToScreen(worldCellRect(x, y))
+}
+
 // getGridCoordinates converts screen coordinates to grid coordinates
 func (m *MapGridView) getGridCoordinates(screenX, screenY int) (int, int) {
-	// This is a simplified implementation
-	// The actual conversion depends on the specific layout of the map grid
-
-	// Main view area: (0,40,1040,560)
-	if screenX < 0 || screenX >= 1040 || screenY < 40 || screenY >= 600 {
+	grid := layoutRect(RegionMapGrid)
+	if !grid.Contains(screenX, screenY) {
 		return -1, -1
 	}
 
-	// Convert to grid coordinates (this is a placeholder implementation)
-	gridX := screenX / 100        // assuming each grid cell is 100px wide
-	gridY := (screenY - 40) / 100 // assuming each grid cell is 100px tall
+	world := m.camera.ToWorld(geom.PointF{X: float64(screenX), Y: float64(screenY)})
+	if world.X < 0 || world.Y < 0 {
+		return -1, -1
+	}
+	gridX := int(world.X) / mapCellSize
+	gridY := int(world.Y) / mapCellSize
 
 	size := m.ViewModel.Size()
 	if gridX >= size.X || gridY >= size.Y {
@@ -72,85 +185,125 @@ func (m *MapGridView) getGridCoordinates(screenX, screenY int) (int, int) {
 	return gridX, gridY
 }
 
+// visibleCells returns the range of the grid cells inside the viewport. The ends are exclusive.
+func (m *MapGridView) visibleCells(size core.MapGridSize) (x0, y0, x1, y1 int) {
+	visible := m.camera.VisibleWorld()
+	x0 = max(int(math.Floor(visible.X/mapCellSize)), 0)
+	y0 = max(int(math.Floor(visible.Y/mapCellSize)), 0)
+	x1 = min(int(math.Ceil((visible.X+visible.Width)/mapCellSize)), size.X)
+	y1 = min(int(math.Ceil((visible.Y+visible.Height)/mapCellSize)), size.Y)
+	return x0, y0, x1, y1
+}
+
 // Draw handles the drawing process
 func (m *MapGridView) Draw(screen *ebiten.Image) {
 	size := m.ViewModel.Size()
+	m.Camera()
 
-	// Draw grid points
-	for y := 0; y < size.Y; y++ {
-		for x := 0; x < size.X; x++ {
+	// Draw only inside the viewport, so a zoomed grid does not cover the other widgets.
+	viewport := layoutRect(RegionMapGrid)
+	clip := image.Rect(int(viewport.X), int(viewport.Y), int(viewport.X+viewport.Width), int(viewport.Y+viewport.Height))
+	view := screen.SubImage(clip).(*ebiten.Image)
+
+	// Draw grid points. Points outside the viewport are culled.
+	x0, y0, x1, y1 := m.visibleCells(size)
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
 			pointVM := m.ViewModel.Point(x, y)
 			if pointVM != nil {
-				m.drawPoint(screen, x, y, pointVM)
+				m.drawPoint(view, x, y, pointVM)
 			}
 		}
 	}
 
 	// Draw connections between points
-	m.drawConnections(screen, size)
+	m.drawConnections(view, x0, y0, x1, y1)
+
+	// Draw the keyboard or gamepad focus
+	if m.focusVisible {
+		drawFocusFrame(view, m.cellRect(m.focusX, m.focusY))
+	}
 }
 
-// drawPoint draws a single point on the grid
+// drawPoint draws a single point on the grid, scaled by the zoom
 func (m *MapGridView) drawPoint(screen *ebiten.Image, x, y int, pointVM *viewmodel.PointViewModel) {
-	// Calculate screen position
-	screenX := float64(x*100 + 50) // Center of grid cell
-	screenY := float64(y*100 + 90) // Center of grid cell (offset by 40 for main view)
+	// Center of grid cell
+	center := m.cellRect(x, y).Center()
+	screenX, screenY := center.X, center.Y
+	zoom := m.camera.Zoom
 
 	// Draw point image
-	image := pointVM.Image()
-	if image != nil {
+	pointImage := pointVM.Image()
+	if pointImage != nil {
 		opt := &ebiten.DrawImageOptions{}
-		opt.GeoM.Translate(screenX-16, screenY-16) // Center the 32x32 image
-		screen.DrawImage(image, opt)
+		opt.GeoM.Scale(zoom, zoom)
+		opt.GeoM.Translate(screenX-16*zoom, screenY-16*zoom) // Center the 32x32 image
+		screen.DrawImage(pointImage, opt)
 	}
 
 	// Draw point name
 	name := pointVM.Name()
 	if name != "" {
 		opt := &ebiten.DrawImageOptions{}
-		opt.GeoM.Translate(screenX-20, screenY+20)
-		drawing.DrawText(screen, name, 12, opt)
+		opt.GeoM.Translate(screenX-20*zoom, screenY+20*zoom)
+		drawing.DrawText(screen, name, mapTextSize(12, zoom), opt)
 	}
 
 	// Draw enemy power if applicable
 	if pointVM.HasEnemy() {
-		power := pointVM.EnemyPower()
 		opt := &ebiten.DrawImageOptions{}
-		opt.GeoM.Translate(screenX+15, screenY-15)
-		drawing.DrawText(screen, fmt.Sprintf("%.0f", power), 10, opt)
+		opt.GeoM.Translate(screenX+15*zoom, screenY-15*zoom)
+		drawing.DrawText(screen, lang.Decimal(pointVM.EnemyPower(), 0), mapTextSize(10, zoom), opt)
+
+		// Draw the enemy skills revealed by accessibility or a watchtower
+		if pointVM.IsEnemyRevealed() {
+			for i, name := range pointVM.EnemySkillNames() {
+				opt := &ebiten.DrawImageOptions{}
+				opt.GeoM.Translate(screenX+15*zoom, screenY+(float64(i)*10-3)*zoom)
+				drawing.DrawText(screen, name, mapTextSize(8, zoom), opt)
+			}
+		}
 	}
 }
 
-// drawConnections draws lines connecting adjacent points
-func (m *MapGridView) drawConnections(screen *ebiten.Image, size core.MapGridSize) {
-	for y := 0; y < size.Y; y++ {
-		for x := 0; x < size.X; x++ {
+// mapTextSize scales the font size by the zoom. It is rounded, since text images are cached for each size.
+func mapTextSize(size, zoom float64) float64 {
+	return max(math.Round(size*zoom), 1)
+}
+
+// drawConnections draws lines connecting adjacent points in the visible cells.
+// The lines to the right of the cells on the left edge and up from the row below the bottom edge are drawn too, since they cross the viewport.
+func (m *MapGridView) drawConnections(screen *ebiten.Image, x0, y0, x1, y1 int) {
+	size := m.ViewModel.Size()
+	// Lines start and end at the edges of the 32x32 point images
+	edge := 16 * m.camera.Zoom
+
+	for y := y0; y < min(y1+1, size.Y); y++ {
+		for x := max(x0-1, 0); x < x1; x++ {
+			center := m.cellRect(x, y).Center()
+
 			// Draw line to the right
 			if m.ViewModel.ShouldDrawLineToRight(x, y) {
-				startX := float64(x*100 + 66)
-				startY := float64(y*100 + 90)
-				endX := float64((x+1)*100 + 34)
-				endY := float64(y*100 + 90)
-				m.drawLine(screen, startX, startY, endX, endY)
+				right := m.cellRect(x+1, y).Center()
+				m.drawLine(screen, center.X+edge, center.Y, right.X-edge, right.Y)
 			}
 
 			// Draw line upward
 			if m.ViewModel.ShouldDrawLineToUpper(x, y) {
-				startX := float64(x*100 + 50)
-				startY := float64(y*100 + 74)
-				endX := float64(x*100 + 50)
-				endY := float64((y-1)*100 + 106)
-				m.drawLine(screen, startX, startY, endX, endY)
+				upper := m.cellRect(x, y-1).Center()
+				m.drawLine(screen, center.X, center.Y-edge, upper.X, upper.Y+edge)
 			}
 		}
 	}
 }
 
-// drawLine draws a line between two points
+// drawLine draws a horizontal or vertical line between two points
 func (m *MapGridView) drawLine(screen *ebiten.Image, x1, y1, x2, y2 float64) {
 	// Simple line drawing using rectangles
-	length := ((x2-x1)*(x2-x1) + (y2-y1)*(y2-y1))
-	if length > 0 {
-		drawing.DrawRect(screen, x1, y1, x2-x1, 2, 0.5, 0.5, 0.5, 1.0)
+	const thickness = 2
+	width, height := math.Abs(x2-x1), math.Abs(y2-y1)
+	if width == 0 && height == 0 {
+		return
 	}
+	drawing.DrawRect(screen, min(x1, x2), min(y1, y2), max(width, thickness), max(height, thickness), 0.5, 0.5, 0.5, 1.0)
 }
@@ -1,11 +1,10 @@
 package ui
 
 import (
-	"fmt"
-
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/flow"
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
 	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
 )
@@ -14,6 +13,8 @@ import (
 type MarketView struct {
 	flow      *flow.MarketFlow
 	viewModel *viewmodel.MarketViewModel
+
+	focus focusRing
 }
 
 // NewMarketView creates a MarketView
@@ -27,112 +28,109 @@ func NewMarketView(flow *flow.MarketFlow, viewModel *viewmodel.MarketViewModel)
 func (mv *MarketView) Select(x, y int) {
 	mv.flow.SelectMarket(x, y)
 	mv.viewModel.SelectMarket(x, y)
+	mv.focus.Reset()
 }
 
 // HandleInput processes input
 func (mv *MarketView) HandleInput(input *Input) (back bool, err error) {
-	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
-		cursorX, cursorY := input.Mouse.CursorPosition()
+	if input.IsActionJustPressed(ActionBack) {
+		return true, nil
+	}
+	if mv.focus.HandleInput(input, mv.focusTargets()) {
+		return true, nil
+	}
 
-		// Back button click detection (960,40,80,80)
-		if cursorX >= 960 && cursorX < 1040 && cursorY >= 40 && cursorY < 120 {
-			return true, nil
-		}
+	if mv.backButton().IsClicked(input) {
+		return true, nil
+	}
 
-		// CardPack click detection and purchase processing
-		if mv.handleMarketItemClick(cursorX, cursorY) {
-			return true, nil
+	// CardPack click detection and purchase processing
+	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
+		cursorX, cursorY := input.Mouse.CursorPosition()
+		for i := range mv.numItems() {
+			if marketItemRect(i).Contains(cursorX, cursorY) {
+				return mv.flow.Purchase(i), nil
+			}
 		}
 	}
 	return false, nil
 }
 
-// handleMarketItemClick handles MarketItem clicks
-func (mv *MarketView) handleMarketItemClick(cursorX, cursorY int) (purchased bool) {
-	positions := [][4]int{
-		{0, 120, 520, 160},   // Top left
-		{520, 120, 520, 160}, // Top right
-		{0, 280, 520, 160},   // Middle left
-		{520, 280, 520, 160}, // Middle right
-		{0, 440, 520, 160},   // Bottom left
-		{520, 440, 520, 160}, // Bottom right
-	}
+// numItems returns the number of MarketItems which fit in the view.
+func (mv *MarketView) numItems() int {
+	return min(mv.viewModel.NumItems(), marketItemCols*marketItemRows)
+}
 
-	for i, pos := range positions {
-		if cursorX >= pos[0] && cursorX < pos[0]+pos[2] &&
-			cursorY >= pos[1] && cursorY < pos[1]+pos[3] {
+// MarketItems are shown in 2 columns and 3 rows.
+const (
+	marketItemCols = 2
+	marketItemRows = 3
+)
 
-			return mv.flow.Purchase(i)
-		}
-	}
+// marketItemRect returns the area of the i-th MarketItem.
+func marketItemRect(i int) geom.Rect {
+	return layoutRect(RegionMarketItems).Cell(marketItemCols, marketItemRows, i)
+}
 
-	return false
+func (mv *MarketView) backButton() Button {
+	return Button{Rect: layoutRect(RegionMainBack), ImageKey: "ui-close", Color: buttonColorLight}
+}
+
+// focusTargets returns the MarketItems and the back button in focus order.
+func (mv *MarketView) focusTargets() []focusTarget {
+	numItems := mv.numItems()
+	targets := make([]focusTarget, 0, numItems+1)
+	for i := range numItems {
+		targets = append(targets, focusTarget{
+			rect:     marketItemRect(i),
+			activate: func() bool { return mv.flow.Purchase(i) },
+		})
+	}
+	return append(targets, mv.backButton().focusTarget(func() bool { return true }))
 }
 
 // Draw handles the drawing process
 func (mv *MarketView) Draw(screen *ebiten.Image) {
-	// Draw header (0,40,960,80)
+	// Draw header
 	mv.drawHeader(screen)
 
-	// Draw back button (960,40,80,80)
-	mv.drawBackButton(screen)
+	// Draw back button
+	mv.backButton().Draw(screen)
 
 	// Draw CardPack list
 	mv.drawMarketItems(screen)
+
+	mv.focus.Draw(screen, mv.focusTargets())
 }
 
 // drawHeader draws the Nation name header
 func (mv *MarketView) drawHeader(screen *ebiten.Image) {
 	// Header background
-	vertices := []ebiten.Vertex{
-		{DstX: 0, DstY: 40, SrcX: 0, SrcY: 0, ColorR: 0.3, ColorG: 0.3, ColorB: 0.3, ColorA: 1},
-		{DstX: 960, DstY: 40, SrcX: 0, SrcY: 0, ColorR: 0.3, ColorG: 0.3, ColorB: 0.3, ColorA: 1},
-		{DstX: 960, DstY: 120, SrcX: 0, SrcY: 0, ColorR: 0.3, ColorG: 0.3, ColorB: 0.3, ColorA: 1},
-		{DstX: 0, DstY: 120, SrcX: 0, SrcY: 0, ColorR: 0.3, ColorG: 0.3, ColorB: 0.3, ColorA: 1},
-	}
-	indices := []uint16{0, 1, 2, 0, 2, 3}
-	screen.DrawTriangles(vertices, indices, drawing.WhitePixel, &ebiten.DrawTrianglesOptions{})
+	header := layoutRect(RegionMainHeader)
+	drawing.DrawRect(screen, header.X, header.Y, header.Width, header.Height, 0.3, 0.3, 0.3, 1)
 
 	// Nation name text
 	opt := &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(20, 60)
+	opt.GeoM.Translate(header.X+20, header.Y+20)
 	drawing.DrawText(screen, mv.viewModel.Title(), 32, opt)
 
 	// Market level text
 	opt = &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(600, 60)
+	opt.GeoM.Translate(header.X+600, header.Y+20)
 	marketLevel := lang.ExecuteTemplate("ui-market-level", map[string]any{"level": mv.viewModel.Level()})
 	drawing.DrawText(screen, marketLevel, 28, opt)
 }
 
-// drawBackButton draws the back button
-func (mv *MarketView) drawBackButton(screen *ebiten.Image) {
-	DrawButton(screen, 960, 40, 80, 80, "ui-close")
-}
-
 // drawMarketItems draws the list of MarketItems
 func (mv *MarketView) drawMarketItems(screen *ebiten.Image) {
-	numItems := mv.viewModel.NumItems()
-
-	// CardPack display area: 520x160 x 6
-	// Positions: (0,120,520,160), (520,120,520,160), (0,280,520,160), (520,280,520,160), (0,440,520,160), (520,440,520,160)
-	positions := [][4]float64{
-		{0, 120, 520, 160},   // Top left
-		{520, 120, 520, 160}, // Top right
-		{0, 280, 520, 160},   // Middle left
-		{520, 280, 520, 160}, // Middle right
-		{0, 440, 520, 160},   // Bottom left
-		{520, 440, 520, 160}, // Bottom right
-	}
-
-	for i := 0; i < numItems; i++ {
+	for i := range mv.numItems() {
 		item, ok := mv.viewModel.Item(i)
 		if !ok {
 			continue
 		}
 
-		pos := positions[i]
-		mv.drawMarketItem(screen, item, i, pos[0], pos[1], pos[2], pos[3])
+		rect := marketItemRect(i)
+		mv.drawMarketItem(screen, item, i, rect.X, rect.Y, rect.Width, rect.Height)
 	}
 }
 
@@ -174,7 +172,7 @@ func (mv *MarketView) drawMarketItem(screen *ebiten.Image, item *viewmodel.Marke
 	}
 
 	// CardPack price (0,240,520,40) -> relative position (0,120,520,40)
-	mv.drawCardPackPrice(screen, item, index, x, y+120, 520, 40)
+	mv.drawCardPackPrice(screen, item, index, x, y+120, width, 40)
 }
 
 // drawCardPackImage draws the CardPack image
@@ -231,7 +229,7 @@ func (mv *MarketView) drawCardPackPrice(screen *ebiten.Image, item *viewmodel.Ma
 			if !canPurchase || resource.red {
 				opt.ColorScale.Scale(1, 0, 0, 1)
 			}
-			priceText := fmt.Sprintf("%d", resource.value)
+			priceText := lang.Number(resource.value)
 			drawing.DrawText(screen, priceText, 24, opt)
 
 			currentX += 120
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
+	"github.com/noppikinatta/ebitenginegamejam2025/geom"
+)
+
+// Minimap is a small view of the whole map grid with a frame around the area shown by MapGridView.
+// Position: RegionMinimap (1050,490,220,180), inside InfoView.
+// It is shown on the map while the grid does not fit in the viewport, unless it is hidden with ActionToggleMinimap.
+// Clicking or dragging on it moves the camera.
+type Minimap struct {
+	centerViewModer CenterViewModer
+	mapGrid         *MapGridView
+	hidden          bool
+}
+
+// NewMinimap creates a Minimap of mapGrid.
+func NewMinimap(centerViewModer CenterViewModer, mapGrid *MapGridView) *Minimap {
+	return &Minimap{
+		centerViewModer: centerViewModer,
+		mapGrid:         mapGrid,
+	}
+}
+
+// Visible returns true if the minimap is shown.
+func (mm *Minimap) Visible() bool {
+	if mm.hidden || mm.centerViewModer.CurrentViewMode() != ViewTypeMapGrid {
+		return false
+	}
+	camera := mm.mapGrid.Camera()
+	return !camera.VisibleWorld().ContainsRect(camera.World)
+}
+
+// HandleInput toggles the minimap and moves the camera to the clicked point. It returns true if the input is used.
+func (mm *Minimap) HandleInput(input *Input) bool {
+	if mm.centerViewModer.CurrentViewMode() == ViewTypeMapGrid && input.IsActionJustPressed(ActionToggleMinimap) {
+		mm.hidden = !mm.hidden
+		return true
+	}
+	if !mm.Visible() || !input.Mouse.IsPressed(ebiten.MouseButtonLeft) {
+		return false
+	}
+
+	cursorX, cursorY := input.Mouse.CursorPosition()
+	if !layoutRect(RegionMinimap).Contains(cursorX, cursorY) {
+		return false
+	}
+	origin, scale := mm.transform()
+	cursor := geom.PointF{X: float64(cursorX), Y: float64(cursorY)}
+	mm.mapGrid.Camera().CenterOn(cursor.Subtract(origin).Multiply(1 / scale))
+	return true
+}
+
+// transform returns the screen position of the world origin and the scale which fit the whole grid in RegionMinimap.
+func (mm *Minimap) transform() (origin geom.PointF, scale float64) {
+	r := layoutRect(RegionMinimap)
+	world := mm.mapGrid.Camera().World
+	if world.Width <= 0 || world.Height <= 0 {
+		return r.TopLeft(), 1
+	}
+	scale = min(r.Width/world.Width, r.Height/world.Height)
+	// Center the grid in the region.
+	origin = geom.PointF{
+		X: r.X + (r.Width-world.Width*scale)/2 - world.X*scale,
+		Y: r.Y + (r.Height-world.Height*scale)/2 - world.Y*scale,
+	}
+	return origin, scale
+}
+
+// toMinimap converts a world rectangle to the screen coordinates of the minimap.
+func (mm *Minimap) toMinimap(r geom.Rect) geom.Rect {
+	origin, scale := mm.transform()
+	return geom.Rect{X: origin.X + r.X*scale, Y: origin.Y + r.Y*scale, Width: r.Width * scale, Height: r.Height * scale}
+}
+
+// Draw draws the points of the grid and the frame of the visible area.
+func (mm *Minimap) Draw(screen *ebiten.Image) {
+	if !mm.Visible() {
+		return
+	}
+
+	r := layoutRect(RegionMinimap)
+	drawing.DrawRect(screen, r.X, r.Y, r.Width, r.Height, 0.05, 0.05, 0.1, 1)
+
+	size := mm.mapGrid.ViewModel.Size()
+	for y := range size.Y {
+		for x := range size.X {
+			pointVM := mm.mapGrid.ViewModel.Point(x, y)
+			if pointVM == nil {
+				continue
+			}
+			cell := mm.toMinimap(worldCellRect(x, y))
+			red, green, blue, alpha := pointVM.MinimapColor().RGBA()
+			drawing.DrawRect(screen, cell.X+1, cell.Y+1, max(cell.Width-2, 1), max(cell.Height-2, 1), red, green, blue, alpha)
+		}
+	}
+
+	camera := mm.mapGrid.Camera()
+	frame := mm.toMinimap(camera.VisibleWorld().Intersect(camera.World))
+	drawing.DrawRect(screen, frame.X, frame.Y, frame.Width, 1, 1, 1, 1, 1)
+	drawing.DrawRect(screen, frame.X, frame.Y+frame.Height-1, frame.Width, 1, 1, 1, 1, 1)
+	drawing.DrawRect(screen, frame.X, frame.Y, 1, frame.Height, 1, 1, 1, 1)
+	drawing.DrawRect(screen, frame.X+frame.Width-1, frame.Y, 1, frame.Height, 1, 1, 1, 1)
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // ResourceView is a widget for displaying resources.
-// Position: (0,0,600,40).
+// Position: RegionResource (0,0,600,40).
 // Displays 5 types of resources in 120x40 each.
 type ResourceView struct {
 	ViewModel *viewmodel.ResourceViewModel
@@ -30,18 +30,19 @@ func (rv *ResourceView) Draw(screen *ebiten.Image) {
 	yield := rv.ViewModel.Yield()
 
 	// Display 5 types of resources at 120x40 each.
-	// Money (0, 0, 120, 40).
-	DrawResource(screen, 0, 0, "resource-money", resources.Money, yield.Money)
-
-	// Food (120, 0, 120, 40).
-	DrawResource(screen, 120, 0, "resource-food", resources.Food, yield.Food)
-
-	// Wood (240, 0, 120, 40).
-	DrawResource(screen, 240, 0, "resource-wood", resources.Wood, yield.Wood)
-
-	// Iron (360, 0, 120, 40).
-	DrawResource(screen, 360, 0, "resource-iron", resources.Iron, yield.Iron)
-
-	// Mana (480, 0, 120, 40).
-	DrawResource(screen, 480, 0, "resource-mana", resources.Mana, yield.Mana)
+	items := []struct {
+		key          string
+		value, yield int
+	}{
+		{"resource-money", resources.Money, yield.Money},
+		{"resource-food", resources.Food, yield.Food},
+		{"resource-wood", resources.Wood, yield.Wood},
+		{"resource-iron", resources.Iron, yield.Iron},
+		{"resource-mana", resources.Mana, yield.Mana},
+	}
+	area := layoutRect(RegionResource)
+	for i, item := range items {
+		r := area.Row(i, 120, area.Height, 0)
+		DrawResource(screen, r.X, r.Y, item.key, item.value, item.yield)
+	}
 }
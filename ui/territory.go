@@ -1,12 +1,11 @@
 package ui
 
 import (
-	"fmt"
-
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
 	"github.com/noppikinatta/ebitenginegamejam2025/flow"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 	"github.com/noppikinatta/ebitenginegamejam2025/viewmodel"
 )
 
@@ -17,12 +16,16 @@ type TerritoryView struct {
 	TerritoryFlow      *flow.TerritoryFlow
 
 	hoveredCardIndex int
+
+	focus focusRing
+	drag  *cardDrag
 }
 
 // NewTerritoryView creates a TerritoryView
-func NewTerritoryView(territoryFlow *flow.TerritoryFlow) *TerritoryView {
+func NewTerritoryView(territoryFlow *flow.TerritoryFlow, drag *cardDrag) *TerritoryView {
 	return &TerritoryView{
 		TerritoryFlow: territoryFlow,
+		drag:          drag,
 	}
 }
 
@@ -31,55 +34,150 @@ func (tv *TerritoryView) Select(x, y int) {
 	if ok {
 		tv.TerritoryViewModel = vm
 	}
+	tv.focus.Reset()
 }
 
 // HandleInput handles input
 func (tv *TerritoryView) HandleInput(input *Input) (back bool, err error) {
+	if input.IsActionJustPressed(ActionBack) {
+		return tv.cancel(), nil
+	}
+	if input.IsActionJustPressed(ActionProceed) {
+		return tv.commit(), nil
+	}
+	if tv.focus.HandleInput(input, tv.focusTargets()) {
+		return true, nil
+	}
+
 	cursorX, cursorY := input.Mouse.CursorPosition()
 	cardIndex := tv.cardIndex(cursorX, cursorY)
 	tv.hoveredCardIndex = cardIndex
 
-	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
-		if cardIndex != -1 {
-			tv.handleCardClick(cardIndex)
+	if input.Mouse.IsJustPressed(ebiten.MouseButtonLeft) && cardIndex != -1 {
+		if card, ok := tv.TerritoryViewModel.Card(cardIndex); ok {
+			tv.drag.Start(cardDragFromPlaced, cardIndex, card, cursorX, cursorY)
 		}
+	}
+	if input.Mouse.IsJustReleased(ebiten.MouseButtonLeft) {
+		tv.dropCard(cursorX, cursorY)
+	}
+	if tv.drag.Active() {
+		return false, nil
+	}
+	if tv.backButton().IsClicked(input) {
+		return tv.cancel(), nil
+	}
+	if tv.levelUpButton().IsClicked(input) {
+		return tv.levelUp(), nil
+	}
+	if tv.upgradeTerrainButton().IsClicked(input) {
+		return tv.upgradeTerrain(), nil
+	}
+	if tv.confirmButton().IsClicked(input) {
+		return tv.commit(), nil
+	}
 
-		// Click detection for back button (960,40,80,80)
-		if cursorX >= 960 && cursorX < 1040 && cursorY >= 40 && cursorY < 120 {
-			tv.TerritoryFlow.Rollback()
-			return true, nil
-		}
+	return false, nil
+}
 
-		// Click detection for confirm button (400,560,240,40)
-		if cursorX >= 400 && cursorX < 640 && cursorY >= 560 && cursorY < 600 {
-			tv.TerritoryFlow.Commit()
-			return true, nil
-		}
+func (tv *TerritoryView) cardSlots() CardSlots {
+	return CardSlots{Rect: layoutRect(RegionPlacedCards)}
+}
+
+func (tv *TerritoryView) backButton() Button {
+	return Button{Rect: layoutRect(RegionMainBack), Text: "Back", TextSize: 20, Color: buttonColor}
+}
+
+func (tv *TerritoryView) confirmButton() Button {
+	return Button{Rect: layoutRect(RegionMainAction), Text: "Confirm", TextSize: 20, Color: buttonColorPositive}
+}
+
+// levelUpButton and upgradeTerrainButton have the cost drawn by drawCostButton, so they have no text.
+func (tv *TerritoryView) levelUpButton() Button {
+	return Button{Rect: layoutRect(RegionLevelUp), Color: costButtonColor(tv.TerritoryViewModel != nil && tv.TerritoryViewModel.CanLevelUp())}
+}
+
+func (tv *TerritoryView) upgradeTerrainButton() Button {
+	return Button{Rect: layoutRect(RegionTerrain), Color: costButtonColor(tv.TerritoryViewModel != nil && tv.TerritoryViewModel.CanUpgradeTerrain())}
+}
+
+// costButtonColor grays out disabled buttons.
+func costButtonColor(enabled bool) drawing.ColorF32 {
+	if enabled {
+		return buttonColorEnabled
 	}
+	return buttonColor
+}
 
-	return false, nil
+// focusTargets returns the placed cards and the buttons in focus order.
+func (tv *TerritoryView) focusTargets() []focusTarget {
+	var targets []focusTarget
+	if tv.TerritoryViewModel == nil {
+		return targets
+	}
+	slots := tv.cardSlots()
+	for i := range tv.TerritoryViewModel.NumCards() {
+		targets = append(targets, focusTarget{
+			rect: slots.SlotRect(i),
+			activate: func() bool {
+				tv.handleCardClick(i)
+				return false
+			},
+		})
+	}
+	targets = append(targets, tv.confirmButton().focusTarget(tv.commit))
+	if _, ok := tv.TerritoryViewModel.TerrainUpgradeText(); ok {
+		targets = append(targets, tv.upgradeTerrainButton().focusTarget(tv.upgradeTerrain))
+	}
+	if _, ok := tv.TerritoryViewModel.NextLevelCost(); ok {
+		targets = append(targets, tv.levelUpButton().focusTarget(tv.levelUp))
+	}
+	return append(targets, tv.backButton().focusTarget(tv.cancel))
+}
+
+// cancel discards the construction plan and returns true to go back to the map.
+func (tv *TerritoryView) cancel() bool {
+	tv.TerritoryFlow.Rollback()
+	return true
+}
+
+// commit constructs the planned structures and returns true to go back to the map.
+func (tv *TerritoryView) commit() bool {
+	tv.TerritoryFlow.Commit()
+	return true
 }
 
-// cardIndex calculates which card index the cursor is over
+func (tv *TerritoryView) levelUp() bool {
+	tv.TerritoryFlow.LevelUp()
+	return false
+}
+
+func (tv *TerritoryView) upgradeTerrain() bool {
+	tv.TerritoryFlow.UpgradeTerrain()
+	return false
+}
+
+// cardIndex calculates which placed card the cursor is over
 func (tv *TerritoryView) cardIndex(cursorX, cursorY int) int {
-	// Territory card area calculation (simplified)
-	if cursorY < 400 || cursorY >= 520 { // Card area roughly
+	if tv.TerritoryViewModel == nil {
 		return -1
 	}
+	return tv.cardSlots().IndexAt(cursorX, cursorY, tv.TerritoryViewModel.NumCards())
+}
 
-	cardX := (cursorX - 100) / 80 // Assuming cards start at x=100 and are 80px wide
-	if cardX < 0 {
-		return -1
+// dropCard finishes a click or a drag of a planned card. A click or a drop on the deck returns the card to the deck.
+func (tv *TerritoryView) dropCard(cursorX, cursorY int) {
+	from, dragged, ok := tv.drag.Drop(cardDragFromPlaced)
+	if !ok || tv.TerritoryViewModel == nil {
+		return
 	}
 
-	if tv.TerritoryViewModel != nil {
-		numCards := tv.TerritoryViewModel.NumCards()
-		if cardX >= numCards {
-			return -1
-		}
+	switch drop, to := dropPlaced(tv.cardSlots(), tv.TerritoryViewModel.NumCards(), from, dragged, cursorX, cursorY); drop {
+	case placedCardRemove:
+		tv.handleCardClick(from)
+	case placedCardMove:
+		tv.TerritoryFlow.MoveInPlan(from, to)
 	}
-
-	return cardX
 }
 
 // handleCardClick handles clicking on territory cards
@@ -114,6 +212,8 @@ func (tv *TerritoryView) Draw(screen *ebiten.Image) {
 
 	// Draw yield information
 	tv.drawYieldInfo(screen)
+
+	tv.focus.Draw(screen, tv.focusTargets())
 }
 
 // drawTerritoryInfo draws territory information
@@ -123,48 +223,46 @@ func (tv *TerritoryView) drawTerritoryInfo(screen *ebiten.Image) {
 	maxCards := tv.TerritoryViewModel.CardSlot()
 	opt := &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(50, 120)
-	drawing.DrawText(screen, fmt.Sprintf("Cards: %d/%d", currentCards, maxCards), 20, opt)
+	drawing.DrawText(screen, lang.ExecuteTemplate("territory-cards", map[string]any{"cards": currentCards, "slot": maxCards}), 20, opt)
+
+	// Draw development level
+	opt = &ebiten.DrawImageOptions{}
+	opt.GeoM.Translate(50, 150)
+	drawing.DrawText(screen, tv.TerritoryViewModel.LevelText(), 20, opt)
 }
 
-// drawStructureCards draws the placed structure cards
+// drawStructureCards draws the placed structure cards and the empty slots
 func (tv *TerritoryView) drawStructureCards(screen *ebiten.Image) {
-	numCards := tv.TerritoryViewModel.NumCards()
-
-	for i := 0; i < numCards; i++ {
-		x := float64(100 + i*80)
-		y := float64(400)
+	tv.cardSlots().Draw(screen, tv.TerritoryViewModel.NumCards(), tv.TerritoryViewModel.CardSlot(), tv.TerritoryViewModel.Card, tv.hoveredCardIndex)
+	tv.drag.drawPlaced(screen, tv.cardSlots(), tv.TerritoryViewModel.NumCards())
+}
 
-		card, ok := tv.TerritoryViewModel.Card(i)
-		if !ok {
-			DrawCardBackground(screen, x, y, 0.5)
-			continue
-		}
+// drawButtons draws UI buttons
+func (tv *TerritoryView) drawButtons(screen *ebiten.Image) {
+	tv.backButton().Draw(screen)
+	tv.confirmButton().Draw(screen)
 
-		DrawCard(screen, x, y, card, i == tv.hoveredCardIndex)
+	if cost, ok := tv.TerritoryViewModel.NextLevelCost(); ok {
+		tv.drawCostButton(screen, tv.levelUpButton(), lang.Text("territory-level-up"), cost)
 	}
 
-	// Draw empty card slots
-	maxCards := tv.TerritoryViewModel.CardSlot()
-	for i := numCards; i < maxCards; i++ {
-		x := float64(100 + i*80)
-		y := float64(400)
-		DrawCardBackground(screen, x, y, 0.3)
+	if text, ok := tv.TerritoryViewModel.TerrainUpgradeText(); ok {
+		tv.drawCostButton(screen, tv.upgradeTerrainButton(), text, tv.TerritoryViewModel.TerrainUpgradeCost())
 	}
 }
 
-// drawButtons draws UI buttons
-func (tv *TerritoryView) drawButtons(screen *ebiten.Image) {
-	// Back button (960,40,80,80)
-	drawing.DrawRect(screen, 960, 40, 80, 80, 0.3, 0.3, 0.3, 1.0)
+// drawCostButton draws a button with its text and cost.
+func (tv *TerritoryView) drawCostButton(screen *ebiten.Image, button Button, text string, cost core.ResourceQuantity) {
+	button.Draw(screen)
+
 	opt := &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(980, 65)
-	drawing.DrawText(screen, "Back", 20, opt)
+	opt.GeoM.Translate(button.Rect.X+10, button.Rect.Y+4)
+	drawing.DrawText(screen, text, 14, opt)
 
-	// Confirm button (400,560,240,40)
-	drawing.DrawRect(screen, 400, 560, 240, 40, 0.2, 0.6, 0.2, 1.0)
 	opt = &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(480, 575)
-	drawing.DrawText(screen, "Confirm", 20, opt)
+	opt.GeoM.Translate(button.Rect.X+10, button.Rect.Y+22)
+	costText := resourceText("resource-summary", cost)
+	drawing.DrawText(screen, costText, 12, opt)
 }
 
 // drawYieldInfo draws yield information
@@ -179,8 +277,7 @@ func (tv *TerritoryView) drawYieldInfo(screen *ebiten.Image) {
 
 	opt = &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(400, 220)
-	currentText := fmt.Sprintf("M:%d F:%d W:%d I:%d A:%d",
-		currentYield.Money, currentYield.Food, currentYield.Wood, currentYield.Iron, currentYield.Mana)
+	currentText := resourceText("resource-summary", currentYield)
 	drawing.DrawText(screen, currentText, 16, opt)
 
 	// Predicted yield
@@ -190,8 +287,7 @@ func (tv *TerritoryView) drawYieldInfo(screen *ebiten.Image) {
 
 	opt = &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(400, 280)
-	predictedText := fmt.Sprintf("M:%d F:%d W:%d I:%d A:%d",
-		predictedYield.Money, predictedYield.Food, predictedYield.Wood, predictedYield.Iron, predictedYield.Mana)
+	predictedText := resourceText("resource-summary", predictedYield)
 	drawing.DrawText(screen, predictedText, 16, opt)
 
 	// Calculate difference
@@ -209,7 +305,17 @@ func (tv *TerritoryView) drawYieldInfo(screen *ebiten.Image) {
 
 	opt = &ebiten.DrawImageOptions{}
 	opt.GeoM.Translate(400, 340)
-	diffText := fmt.Sprintf("M:%+.0d F:%+.0d W:%+.0d I:%+.0d A:%+.0d",
-		diff.Money, diff.Food, diff.Wood, diff.Iron, diff.Mana)
+	diffText := resourceText("resource-summary-diff", diff)
 	drawing.DrawText(screen, diffText, 16, opt)
 }
+
+// resourceText formats q with the lang template key, whose fields are money, food, wood, iron and mana.
+func resourceText(key string, q core.ResourceQuantity) string {
+	return lang.ExecuteTemplate(key, map[string]any{
+		"money": q.Money,
+		"food":  q.Food,
+		"wood":  q.Wood,
+		"iron":  q.Iron,
+		"mana":  q.Mana,
+	})
+}
@@ -1,8 +1,6 @@
 package ui
 
 import (
-	"fmt"
-
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
 	"github.com/noppikinatta/ebitenginegamejam2025/drawing"
@@ -33,9 +31,9 @@ func DrawResource(screen *ebiten.Image, x, y float64, resourceType string, value
 	// Right 80x40 for numerical display
 	var text string
 	if increment != 0 {
-		text = fmt.Sprintf("%d(+%d)", value, increment)
+		text = lang.ExecuteTemplate("ui-resource-amount-yield", map[string]any{"value": value, "yield": increment})
 	} else {
-		text = fmt.Sprintf("%d", value)
+		text = lang.ExecuteTemplate("ui-resource-amount", map[string]any{"value": value})
 	}
 
 	opt = &ebiten.DrawImageOptions{}
@@ -90,32 +88,8 @@ func DrawCard(screen *ebiten.Image, x, y float64, card *viewmodel.CardViewModel,
 		screen.DrawImage(powerIcon, opt)
 		opt = &ebiten.DrawImageOptions{}
 		opt.GeoM.Translate(x+2+32, y+64)
-		drawing.DrawText(screen, fmt.Sprintf("%.1f", card.Power), 24, opt)
-	}
-}
-
-// DrawButton draws a button (click detection to be implemented later)
-func DrawButton(screen *ebiten.Image, x, y, width, height float64, imageKey string) {
-	// Draw button frame
-	vertices := []ebiten.Vertex{
-		{DstX: float32(x), DstY: float32(y), SrcX: 0, SrcY: 0, ColorR: 0.8, ColorG: 0.8, ColorB: 0.8, ColorA: 1},
-		{DstX: float32(x + width), DstY: float32(y), SrcX: 0, SrcY: 0, ColorR: 0.8, ColorG: 0.8, ColorB: 0.8, ColorA: 1},
-		{DstX: float32(x + width), DstY: float32(y + height), SrcX: 0, SrcY: 0, ColorR: 0.8, ColorG: 0.8, ColorB: 0.8, ColorA: 1},
-		{DstX: float32(x), DstY: float32(y + height), SrcX: 0, SrcY: 0, ColorR: 0.8, ColorG: 0.8, ColorB: 0.8, ColorA: 1},
+		drawing.DrawText(screen, lang.Decimal(card.Power, 1), 24, opt)
 	}
-	indices := []uint16{0, 1, 2, 0, 2, 3}
-	screen.DrawTriangles(vertices, indices, drawing.WhitePixel, &ebiten.DrawTrianglesOptions{})
-
-	// Draw button image
-	image := drawing.Image(imageKey)
-	imageBounds := image.Bounds()
-	imageWidth := float64(imageBounds.Dx())
-	imageHeight := float64(imageBounds.Dy())
-	imageX := x + width/2 - imageWidth/2
-	imageY := y + height/2 - imageHeight/2
-	opt := &ebiten.DrawImageOptions{}
-	opt.GeoM.Translate(imageX, imageY)
-	screen.DrawImage(image, opt)
 }
 
 func DrawCardDescriptionTooltip(screen *ebiten.Image, card interface{}, mouseX, mouseY int) {
@@ -133,7 +107,7 @@ func DrawCardDescriptionTooltip(screen *ebiten.Image, card interface{}, mouseX,
 			return
 		}
 		skillName := lang.Text(string(typedCard.Skill.BattleCardSkillID))
-		skillDescription := lang.Text(string(typedCard.Skill.BattleCardSkillID) + "-desc")
+		skillDescription := lang.Text(typedCard.Skill.DescriptionKey)
 		opt.GeoM.Translate(0, 32)
 		drawing.DrawText(screen, skillName, 24, opt)
 		opt.GeoM.Translate(0, 32)
@@ -142,7 +116,7 @@ func DrawCardDescriptionTooltip(screen *ebiten.Image, card interface{}, mouseX,
 		// Structure Card description
 		opt := &ebiten.DrawImageOptions{}
 		opt.GeoM.Translate(float64(mouseX+10), float64(mouseY+10))
-		cardDescription := lang.Text(string(typedCard.ID()) + "-desc")
+		cardDescription := lang.Text(typedCard.DescriptionKey())
 		drawing.DrawText(screen, cardDescription, 20, opt)
 	}
 }
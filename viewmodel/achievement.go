@@ -0,0 +1,79 @@
+package viewmodel
+
+import (
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+)
+
+// AchievementViewModel provides display information for the achievement list
+type AchievementViewModel struct {
+	achievements []*core.Achievement
+	profile      *store.Profile
+}
+
+// NewAchievementViewModel creates a new AchievementViewModel
+func NewAchievementViewModel(achievements []*core.Achievement, profile *store.Profile) *AchievementViewModel {
+	return &AchievementViewModel{
+		achievements: achievements,
+		profile:      profile,
+	}
+}
+
+// Title returns the achievement list title
+func (vm *AchievementViewModel) Title() string {
+	return lang.Text("achievements-title")
+}
+
+// ProgressText returns the localized number of unlocked achievements
+func (vm *AchievementViewModel) ProgressText() string {
+	unlocked := 0
+	for i := range vm.achievements {
+		if vm.IsUnlocked(i) {
+			unlocked++
+		}
+	}
+	return lang.ExecuteTemplate("achievements-progress", map[string]any{
+		"unlocked": unlocked,
+		"total":    len(vm.achievements),
+	})
+}
+
+// NumAchievements returns the number of achievements
+func (vm *AchievementViewModel) NumAchievements() int {
+	return len(vm.achievements)
+}
+
+// IsUnlocked returns whether the achievement at idx has been unlocked
+func (vm *AchievementViewModel) IsUnlocked(idx int) bool {
+	if idx < 0 || idx >= len(vm.achievements) || vm.profile == nil {
+		return false
+	}
+	_, ok := vm.profile.Achievements[string(vm.achievements[idx].ID)]
+	return ok
+}
+
+// Name returns the localized name of the achievement at idx
+func (vm *AchievementViewModel) Name(idx int) string {
+	if idx < 0 || idx >= len(vm.achievements) {
+		return ""
+	}
+	return lang.Text(vm.achievements[idx].NameKey)
+}
+
+// Description returns the localized description of the achievement at idx
+func (vm *AchievementViewModel) Description(idx int) string {
+	if idx < 0 || idx >= len(vm.achievements) {
+		return ""
+	}
+	return lang.Text(vm.achievements[idx].DescriptionKey)
+}
+
+// UnlockedDateText returns the date on which the achievement at idx was unlocked, or a locked label
+func (vm *AchievementViewModel) UnlockedDateText(idx int) string {
+	if !vm.IsUnlocked(idx) {
+		return lang.Text("achievements-locked")
+	}
+	date := vm.profile.Achievements[string(vm.achievements[idx].ID)]
+	return date.Format("2006-01-02")
+}
@@ -26,8 +26,11 @@ func NewBattleViewModel(gameState *core.GameState, battlefield *core.Battlefield
 
 // Title returns the battle title
 func (vm *BattleViewModel) Title() string {
-	// Get localized battle title
-	return lang.Text("battle_title")
+	location := "point-boss"
+	if wilderness, ok := vm.point.(*core.WildernessPoint); ok && wilderness.Terrain() != nil {
+		location = string(wilderness.Terrain().ID())
+	}
+	return lang.ExecuteTemplate("battle-title", map[string]any{"location": lang.Text(location)})
 }
 
 // EnemyImage returns the enemy image
@@ -49,7 +52,7 @@ func (vm *BattleViewModel) EnemyType() string {
 
 	enemy := vm.point.Enemy()
 	// Get localized enemy type name
-	return lang.Text("enemy_type_" + string(enemy.Type()))
+	return lang.Text(string(enemy.Type()))
 }
 
 // EnemyPower returns the enemy power
@@ -61,6 +64,14 @@ func (vm *BattleViewModel) EnemyPower() float64 {
 	return vm.point.Enemy().Power()
 }
 
+// EnemyCurrentPower returns the power needed to defeat the enemy, including the effects of enemy skills
+func (vm *BattleViewModel) EnemyCurrentPower() float64 {
+	if vm.battlefield == nil {
+		return vm.EnemyPower()
+	}
+	return vm.battlefield.CalculateEnemyPower()
+}
+
 // EnemyTalk returns the enemy dialogue
 func (vm *BattleViewModel) EnemyTalk() string {
 	if vm.point == nil || vm.point.Enemy() == nil {
@@ -69,7 +80,7 @@ func (vm *BattleViewModel) EnemyTalk() string {
 
 	enemy := vm.point.Enemy()
 	// Get localized enemy dialogue
-	return lang.Text("enemy_talk_" + string(enemy.ID()))
+	return lang.Text(enemy.TalkKey())
 }
 
 // EnemySkillNames returns the enemy skill names
@@ -83,7 +94,7 @@ func (vm *BattleViewModel) EnemySkillNames() []string {
 	names := make([]string, len(skills))
 
 	for i, skill := range skills {
-		names[i] = lang.Text("enemy_skill_name_" + string(skill.ID()))
+		names[i] = lang.Text(string(skill.ID()))
 	}
 
 	return names
@@ -100,7 +111,7 @@ func (vm *BattleViewModel) EnemySkillDescriptions() []string {
 	descriptions := make([]string, len(skills))
 
 	for i, skill := range skills {
-		descriptions[i] = lang.Text("enemy_skill_desc_" + string(skill.ID()))
+		descriptions[i] = lang.Text(skill.DescriptionKey())
 	}
 
 	return descriptions
@@ -130,6 +141,144 @@ func (vm *BattleViewModel) TotalPower() float64 {
 	return vm.battlefield.CalculateTotalPower()
 }
 
+// BreakdownTexts returns the localized detail of the power calculation
+func (vm *BattleViewModel) BreakdownTexts() []string {
+	if vm.battlefield == nil {
+		return []string{}
+	}
+
+	breakdown := vm.battlefield.Breakdown()
+	cardPower := 0.0
+	for _, power := range breakdown.CardPowers {
+		cardPower += power
+	}
+
+	texts := []string{
+		lang.ExecuteTemplate("battle-breakdown-support", map[string]any{"power": breakdown.SupportPower}),
+		lang.ExecuteTemplate("battle-breakdown-cards", map[string]any{"power": cardPower}),
+	}
+	if breakdown.EnemyPowerBonus > 0 {
+		texts = append(texts, lang.ExecuteTemplate("battle-breakdown-enemy-bonus", map[string]any{"power": breakdown.EnemyPowerBonus}))
+	}
+	return texts
+}
+
+// TerrainEffectTexts returns the localized effects of the terrain on the battle
+func (vm *BattleViewModel) TerrainEffectTexts() []string {
+	if vm.battlefield == nil {
+		return []string{}
+	}
+
+	effects := vm.battlefield.TerrainBattleEffects()
+	texts := make([]string, len(effects))
+	for i, effect := range effects {
+		texts[i] = lang.Text(effect.DescriptionKey)
+	}
+	return texts
+}
+
+// SupportContributionTexts returns the localized support given by each territory
+func (vm *BattleViewModel) SupportContributionTexts() []string {
+	if vm.battlefield == nil {
+		return []string{}
+	}
+
+	texts := make([]string, 0, len(vm.battlefield.SupportContributions))
+	for _, contribution := range vm.battlefield.SupportContributions {
+		texts = append(texts, lang.ExecuteTemplate("battle-support-contribution", map[string]any{
+			"terrain":  lang.Text(string(contribution.Territory.Terrain().ID())),
+			"x":        contribution.X,
+			"y":        contribution.Y,
+			"distance": contribution.Distance,
+			"power":    contribution.Power,
+			"slot":     contribution.CardSlot,
+		}))
+	}
+	return texts
+}
+
+// IsMultiRound returns whether the battle is fought over several rounds
+func (vm *BattleViewModel) IsMultiRound() bool {
+	if vm.battlefield == nil {
+		return false
+	}
+	return vm.battlefield.Mode == core.BattleModeMultiRound
+}
+
+// Round returns the current round
+func (vm *BattleViewModel) Round() int {
+	if vm.battlefield == nil {
+		return 0
+	}
+	return vm.battlefield.CurrentRound()
+}
+
+// MaxRounds returns the number of rounds
+func (vm *BattleViewModel) MaxRounds() int {
+	if vm.battlefield == nil {
+		return 0
+	}
+	return vm.battlefield.MaxRounds
+}
+
+// IsFinalRound returns whether the current round is the last one
+func (vm *BattleViewModel) IsFinalRound() bool {
+	if vm.battlefield == nil {
+		return true
+	}
+	return vm.battlefield.IsFinalRound()
+}
+
+// EnemyHP returns the remaining enemy HP
+func (vm *BattleViewModel) EnemyHP() float64 {
+	if vm.battlefield == nil {
+		return 0.0
+	}
+	return vm.battlefield.EnemyHP
+}
+
+// RoundText returns the localized round progress
+func (vm *BattleViewModel) RoundText() string {
+	return lang.ExecuteTemplate("battle-round", map[string]any{
+		"round":     vm.Round(),
+		"maxRounds": vm.MaxRounds(),
+	})
+}
+
+// IsMultiPhase returns whether the enemy is a boss fought in several phases
+func (vm *BattleViewModel) IsMultiPhase() bool {
+	bossPoint, ok := vm.point.(*core.BossPoint)
+	if !ok {
+		return false
+	}
+	return bossPoint.NumPhases() > 1
+}
+
+// PhaseText returns the localized phase progress of a boss
+func (vm *BattleViewModel) PhaseText() string {
+	bossPoint, ok := vm.point.(*core.BossPoint)
+	if !ok {
+		return ""
+	}
+	return lang.ExecuteTemplate("battle-phase", map[string]any{
+		"phase":     bossPoint.Phase() + 1,
+		"numPhases": bossPoint.NumPhases(),
+	})
+}
+
+// PhaseDialogue returns the localized dialogue of the current boss phase
+func (vm *BattleViewModel) PhaseDialogue() string {
+	bossPoint, ok := vm.point.(*core.BossPoint)
+	if !ok {
+		return ""
+	}
+	phase := bossPoint.CurrentPhase()
+	if phase == nil || phase.DialogueKey == "" {
+		return ""
+	}
+	return lang.Text(phase.DialogueKey)
+}
+
 // NumCards returns the number of placed battle cards
 func (vm *BattleViewModel) NumCards() int {
 	if vm.battlefield == nil {
@@ -152,3 +301,62 @@ func (vm *BattleViewModel) Card(idx int) (*CardViewModel, bool) {
 	vm.cardViewModelCache.FromBattleCard(card) // TODO: show calculated battle power
 	return vm.cardViewModelCache, true
 }
+
+// BattleResultViewModel provides display information for the result of a battle
+type BattleResultViewModel struct {
+	result             *core.BattleResult
+	cardViewModelCache *CardViewModel
+}
+
+// NewBattleResultViewModel creates a new BattleResultViewModel
+func NewBattleResultViewModel(result *core.BattleResult) *BattleResultViewModel {
+	return &BattleResultViewModel{
+		result: result,
+	}
+}
+
+// Title returns the localized result title
+func (vm *BattleResultViewModel) Title() string {
+	if vm.result.Victory {
+		return lang.Text("battle-result-victory")
+	}
+	return lang.Text("battle-result-defeat")
+}
+
+// Victory returns whether the battle was won
+func (vm *BattleResultViewModel) Victory() bool {
+	return vm.result.Victory
+}
+
+// NumSurvivors returns the number of cards returned to the deck
+func (vm *BattleResultViewModel) NumSurvivors() int {
+	return len(vm.result.Survivors)
+}
+
+// Survivor returns the card view model of the survivor at the specified index
+func (vm *BattleResultViewModel) Survivor(idx int) (*CardViewModel, bool) {
+	return vm.card(vm.result.Survivors, idx)
+}
+
+// NumLosses returns the number of cards lost in the battle
+func (vm *BattleResultViewModel) NumLosses() int {
+	return len(vm.result.Losses)
+}
+
+// Loss returns the card view model of the lost card at the specified index
+func (vm *BattleResultViewModel) Loss(idx int) (*CardViewModel, bool) {
+	return vm.card(vm.result.Losses, idx)
+}
+
+func (vm *BattleResultViewModel) card(cards []*core.BattleCard, idx int) (*CardViewModel, bool) {
+	if idx < 0 || idx >= len(cards) {
+		return nil, false
+	}
+
+	if vm.cardViewModelCache == nil {
+		vm.cardViewModelCache = &CardViewModel{}
+	}
+
+	vm.cardViewModelCache.FromBattleCard(cards[idx])
+	return vm.cardViewModelCache, true
+}
@@ -38,3 +38,28 @@ func (vm *CalendarViewModel) YearMonth() string {
 		"month": month,
 	})
 }
+
+// calendarYearOffset is added to the year of a Turn to get the year of the in-game calendar.
+const calendarYearOffset = 1023
+
+// calendarDateText returns the localized in-game calendar date of the turn.
+func calendarDateText(turn core.Turn) string {
+	year, month := turn.YearMonth()
+	return lang.ExecuteTemplate("ui-calendar", map[string]any{"year": year + calendarYearOffset, "month": month})
+}
+
+// RemainingTurnsText returns the localized number of turns before the turn limit, or an empty string if there is no limit
+func (vm *CalendarViewModel) RemainingTurnsText() string {
+	if vm.gameState == nil {
+		return ""
+	}
+
+	for _, condition := range vm.gameState.DefeatConditions {
+		if turnLimit, ok := condition.(*core.DefeatConditionTurnLimit); ok {
+			return lang.ExecuteTemplate("ui-remaining-turns", map[string]any{
+				"turns": turnLimit.RemainingTurns(vm.gameState),
+			})
+		}
+	}
+	return ""
+}
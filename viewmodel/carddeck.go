@@ -7,28 +7,124 @@ import (
 	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 )
 
-// CardDeckViewModel provides display information for card deck UI
+// CardDeckViewModel provides display information for card deck UI.
+// The cards are filtered and sorted by a core.CardQuery.
 type CardDeckViewModel struct {
 	gameState          *core.GameState
 	cardViewModelCache *CardViewModel
+
+	filter      int // filter is the index of the selected filter in filters().
+	sort        core.CardSort
+	cardIDs     []core.CardID // cardIDs is the result of the query, updated by Refresh.
+	stale       bool          // stale is true if the query has changed since cardIDs was built.
+	deckVersion int           // deckVersion is the CardDeck.Version when cardIDs was built.
+	language    string        // language is the language when cardIDs was built, since the cards can be sorted by name.
 }
 
 // NewCardDeckViewModel creates a new CardDeckViewModel
 func NewCardDeckViewModel(gameState *core.GameState) *CardDeckViewModel {
-	return &CardDeckViewModel{
+	vm := &CardDeckViewModel{
 		gameState: gameState,
+		stale:     true,
+	}
+	vm.Refresh()
+	return vm
+}
+
+// deckFilter is a choice of the filter of the deck view.
+type deckFilter struct {
+	kind     core.CardKind
+	cardType core.BattleCardType
+}
+
+// filters returns all battle cards, all structure cards, and the battle cards of each type after everything.
+func (vm *CardDeckViewModel) filters() []deckFilter {
+	filters := []deckFilter{{kind: core.CardKindAll}, {kind: core.CardKindBattle}, {kind: core.CardKindStructure}}
+	for _, cardType := range vm.gameState.CardDictionary.BattleCardTypes() {
+		filters = append(filters, deckFilter{kind: core.CardKindBattle, cardType: cardType})
+	}
+	return filters
+}
+
+// numSorts is the number of core.CardSort values.
+const numSorts = 4
+
+// Refresh applies the filter and the sort to the current deck.
+// It does nothing unless the query, the deck or the language has changed since the last time.
+func (vm *CardDeckViewModel) Refresh() {
+	deckVersion, language := vm.gameState.CardDeck.Version(), lang.Current()
+	if !vm.stale && deckVersion == vm.deckVersion && language == vm.language {
+		return
+	}
+	vm.stale, vm.deckVersion, vm.language = false, deckVersion, language
+
+	filter := vm.filters()[vm.filter]
+	query := core.CardQuery{
+		Kind:   filter.kind,
+		Type:   filter.cardType,
+		Sort:   vm.sort,
+		NameOf: func(cardID core.CardID) string { return lang.Text(string(cardID)) },
+	}
+	vm.cardIDs = query.Apply(vm.gameState.CardDeck, vm.gameState.CardDictionary, vm.gameState.CardDisplayOrder)
+}
+
+// CycleFilter selects the next filter, or the previous one if delta is negative.
+func (vm *CardDeckViewModel) CycleFilter(delta int) {
+	n := len(vm.filters())
+	vm.filter = ((vm.filter+delta)%n + n) % n
+	vm.stale = true
+	vm.Refresh()
+}
+
+// CycleSort selects the next sort mode, or the previous one if delta is negative.
+func (vm *CardDeckViewModel) CycleSort(delta int) {
+	vm.sort = core.CardSort(((int(vm.sort)+delta)%numSorts + numSorts) % numSorts)
+	vm.stale = true
+	vm.Refresh()
+}
+
+// FilterText returns the name of the selected filter.
+func (vm *CardDeckViewModel) FilterText() string {
+	filter := vm.filters()[vm.filter]
+	if filter.cardType != "" {
+		return lang.Text(string(filter.cardType))
+	}
+	switch filter.kind {
+	case core.CardKindBattle:
+		return lang.Text("deck-filter-battle")
+	case core.CardKindStructure:
+		return lang.Text("deck-filter-structure")
+	}
+	return lang.Text("deck-filter-all")
+}
+
+// SortText returns the name of the selected sort mode.
+func (vm *CardDeckViewModel) SortText() string {
+	switch vm.sort {
+	case core.CardSortPower:
+		return lang.Text("deck-sort-power")
+	case core.CardSortCount:
+		return lang.Text("deck-sort-count")
+	case core.CardSortName:
+		return lang.Text("deck-sort-name")
 	}
+	return lang.Text("deck-sort-default")
+}
+
+// NumCards returns the number of card types shown with the current filter.
+func (vm *CardDeckViewModel) NumCards() int {
+	return len(vm.cardIDs)
 }
 
 func (vm *CardDeckViewModel) CardID(idx int) (core.CardID, bool) {
-	if idx < 0 || idx >= len(vm.gameState.CardDisplayOrder) {
+	if idx < 0 || idx >= len(vm.cardIDs) {
 		return "", false
 	}
 
-	return vm.gameState.CardDisplayOrder[idx], true
+	return vm.cardIDs[idx], true
 }
 
-// BattleCard returns battle card view model at the specified index
+// Card returns the card view model at the specified index, with the number of copies in the deck
 func (vm *CardDeckViewModel) Card(idx int) (*CardViewModel, bool) {
 	cardID, ok := vm.CardID(idx)
 	if !ok {
@@ -39,25 +135,30 @@ func (vm *CardDeckViewModel) Card(idx int) (*CardViewModel, bool) {
 		vm.cardViewModelCache = &CardViewModel{}
 	}
 
-	vm.cardViewModelCache.Duplicates = vm.gameState.CardDeck.Count(cardID)
-
 	battleCard, ok := vm.gameState.CardDictionary.BattleCard(cardID)
 	if ok {
 		vm.cardViewModelCache.FromBattleCard(battleCard)
+		vm.cardViewModelCache.Duplicates = vm.gameState.CardDeck.Count(cardID)
 		return vm.cardViewModelCache, true
 	}
 
 	structureCard, ok := vm.gameState.CardDictionary.StructureCard(cardID)
 	if ok {
 		vm.cardViewModelCache.FromStructureCard(structureCard)
+		vm.cardViewModelCache.Duplicates = vm.gameState.CardDeck.Count(cardID)
 		return vm.cardViewModelCache, true
 	}
 
 	return nil, false
 }
 
-func (vm *CardDeckViewModel) CountTypesInHand() int {
-	return vm.gameState.CardDeck.CountTypesInHand()
+// DuplicatesText returns the number of copies of the card in the deck, such as "x3".
+func (vm *CardDeckViewModel) DuplicatesText(idx int) string {
+	cardID, ok := vm.CardID(idx)
+	if !ok {
+		return ""
+	}
+	return lang.ExecuteTemplate("deck-card-count", map[string]any{"count": vm.gameState.CardDeck.Count(cardID)})
 }
 
 func (vm *CardDeckViewModel) IsBattleCard(cardID core.CardID) bool {
@@ -86,6 +187,7 @@ type CardViewModel struct {
 
 func (c *CardViewModel) reset() {
 	c.Image = nil
+	c.Duplicates = 0
 	c.Name = ""
 	c.HasCardType = false
 	c.CardTypeColor = drawing.ColorF32{}
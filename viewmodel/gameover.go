@@ -0,0 +1,43 @@
+package viewmodel
+
+import (
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+)
+
+// GameOverViewModel provides display information for the game over screen
+type GameOverViewModel struct {
+	gameState *core.GameState
+}
+
+// NewGameOverViewModel creates a new GameOverViewModel
+func NewGameOverViewModel(gameState *core.GameState) *GameOverViewModel {
+	return &GameOverViewModel{
+		gameState: gameState,
+	}
+}
+
+// Title returns the game over title
+func (vm *GameOverViewModel) Title() string {
+	return lang.Text("defeat-title")
+}
+
+// ReasonText returns the localized reason of the defeat
+func (vm *GameOverViewModel) ReasonText() string {
+	if vm.gameState == nil {
+		return ""
+	}
+	condition, ok := vm.gameState.Defeat()
+	if !ok {
+		return ""
+	}
+	return lang.Text(condition.ReasonKey())
+}
+
+// TurnText returns the localized date on which the game ended
+func (vm *GameOverViewModel) TurnText() string {
+	if vm.gameState == nil {
+		return ""
+	}
+	return calendarDateText(vm.gameState.CurrentTurn)
+}
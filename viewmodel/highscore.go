@@ -0,0 +1,63 @@
+package viewmodel
+
+import (
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+)
+
+// HighScoreViewModel provides display information for the high score table
+type HighScoreViewModel struct {
+	table *store.HighScoreTable
+}
+
+// NewHighScoreViewModel creates a new HighScoreViewModel
+func NewHighScoreViewModel(table *store.HighScoreTable) *HighScoreViewModel {
+	return &HighScoreViewModel{
+		table: table,
+	}
+}
+
+// Title returns the high score title
+func (vm *HighScoreViewModel) Title() string {
+	return lang.Text("highscore-title")
+}
+
+// NumEntries returns the number of high score entries
+func (vm *HighScoreViewModel) NumEntries() int {
+	if vm.table == nil {
+		return 0
+	}
+	return len(vm.table.Entries)
+}
+
+// EntryText returns the localized text of the high score entry at idx
+func (vm *HighScoreViewModel) EntryText(idx int) string {
+	if idx < 0 || idx >= vm.NumEntries() {
+		return ""
+	}
+
+	entry := vm.table.Entries[idx]
+	difficulty := entry.Difficulty
+	if difficulty != "" {
+		difficulty = lang.Text(difficulty)
+	}
+	resultKey := "highscore-defeat"
+	if entry.Victory {
+		resultKey = "highscore-victory"
+	}
+
+	return lang.ExecuteTemplate("highscore-entry", map[string]any{
+		"rank":       idx + 1,
+		"score":      entry.Score,
+		"result":     lang.Text(resultKey),
+		"turns":      entry.Turns,
+		"difficulty": difficulty,
+		"seed":       entry.Seed,
+		"date":       entry.Date.Format("2006-01-02"),
+	})
+}
+
+// EmptyText returns the text shown when there is no entry
+func (vm *HighScoreViewModel) EmptyText() string {
+	return lang.Text("highscore-empty")
+}
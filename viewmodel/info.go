@@ -1,28 +1,110 @@
 package viewmodel
 
 import (
+	"strings"
+
 	"github.com/noppikinatta/ebitenginegamejam2025/core"
 	"github.com/noppikinatta/ebitenginegamejam2025/lang"
 )
 
+// HistoryFilter selects the kinds of History to show
+type HistoryFilter int
+
+const (
+	HistoryFilterEvents HistoryFilter = iota // Everything except the end of turns
+	HistoryFilterBattles
+	HistoryFilterEconomy
+	HistoryFilterTurns
+	HistoryFilterAll
+)
+
+// HistoryFilters are the filters in display order
+var HistoryFilters = []HistoryFilter{
+	HistoryFilterEvents,
+	HistoryFilterBattles,
+	HistoryFilterEconomy,
+	HistoryFilterTurns,
+	HistoryFilterAll,
+}
+
 type HistoryViewModel struct {
-	gameState *core.GameState
-	eventMap  map[string]any // Temporary map for template execution.
+	gameState   *core.GameState
+	filter      HistoryFilter
+	eventMap    map[string]any // Temporary map for template execution.
+	filtered    core.Histories // Cache of the Histories selected by the filter.
+	filteredLen int            // Length of the log when filtered was built, or -1 if filtered must be rebuilt.
 }
 
 func NewHistoryViewModel(gameState *core.GameState) *HistoryViewModel {
-	return &HistoryViewModel{gameState: gameState}
+	return &HistoryViewModel{gameState: gameState, filteredLen: -1}
+}
+
+// Filter returns the current filter
+func (vm *HistoryViewModel) Filter() HistoryFilter {
+	return vm.filter
+}
+
+// SetFilter sets the filter
+func (vm *HistoryViewModel) SetFilter(filter HistoryFilter) {
+	if vm.filter == filter {
+		return
+	}
+	vm.filter = filter
+	vm.filteredLen = -1
+}
+
+// FilterText returns the localized name of the filter
+func (vm *HistoryViewModel) FilterText(filter HistoryFilter) string {
+	switch filter {
+	case HistoryFilterBattles:
+		return lang.Text("history-filter-battles")
+	case HistoryFilterEconomy:
+		return lang.Text("history-filter-economy")
+	case HistoryFilterTurns:
+		return lang.Text("history-filter-turns")
+	case HistoryFilterAll:
+		return lang.Text("history-filter-all")
+	default:
+		return lang.Text("history-filter-events")
+	}
+}
+
+// histories returns the Histories selected by the filter.
+// The log only grows, so the result is rebuilt only when the filter changes or new Histories are added.
+func (vm *HistoryViewModel) histories() core.Histories {
+	if vm.filteredLen != len(vm.gameState.Histories) {
+		vm.filtered = vm.filterHistories()
+		vm.filteredLen = len(vm.gameState.Histories)
+	}
+	return vm.filtered
+}
+
+// filterHistories selects the Histories by the filter
+func (vm *HistoryViewModel) filterHistories() core.Histories {
+	histories := vm.gameState.Histories
+	switch vm.filter {
+	case HistoryFilterBattles:
+		return histories.OfKind(core.HistoryKindConquest, core.HistoryKindBattleLost)
+	case HistoryFilterEconomy:
+		return histories.OfKind(core.HistoryKindPurchase, core.HistoryKindPackContents, core.HistoryKindMarketLevel, core.HistoryKindConstruction)
+	case HistoryFilterTurns:
+		return histories.OfKind(core.HistoryKindTurnEnded)
+	case HistoryFilterAll:
+		return histories
+	default:
+		return histories.OfKind(
+			core.HistoryKindConquest, core.HistoryKindBattleLost,
+			core.HistoryKindPurchase, core.HistoryKindPackContents, core.HistoryKindMarketLevel, core.HistoryKindConstruction,
+		)
+	}
 }
 
 func (vm *HistoryViewModel) HistoryLen() int {
-	return len(vm.gameState.Histories)
+	return len(vm.histories())
 }
 
 func (vm *HistoryViewModel) HistoryDateText(index int) string {
-	history := vm.gameState.Histories[index]
-	year, month := history.Turn.YearMonth()
-	year += 1023
-	return lang.ExecuteTemplate("ui-calendar", map[string]any{"year": year, "month": month})
+	return calendarDateText(vm.histories()[index].Turn)
 }
 
 func (vm *HistoryViewModel) HistoryEventText(index int) string {
@@ -30,11 +112,18 @@ func (vm *HistoryViewModel) HistoryEventText(index int) string {
 		vm.eventMap = make(map[string]any)
 	}
 
-	history := vm.gameState.Histories[index]
+	history := vm.histories()[index]
 	for k, v := range history.Data {
-		if s, ok := v.(string); ok {
-			vm.eventMap[k] = lang.Text(s)
-		} else {
+		switch v := v.(type) {
+		case string:
+			vm.eventMap[k] = lang.Text(v)
+		case []string:
+			texts := make([]string, len(v))
+			for i, s := range v {
+				texts[i] = lang.Text(s)
+			}
+			vm.eventMap[k] = strings.Join(texts, ", ")
+		default:
 			vm.eventMap[k] = v
 		}
 	}
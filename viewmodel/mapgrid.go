@@ -38,7 +38,7 @@ func (vm *MapGridViewModel) Point(x, y int) *PointViewModel {
 		return nil
 	}
 
-	return NewPointViewModel(vm.gameState, point)
+	return NewPointViewModel(vm.gameState, x, y, point)
 }
 
 // ShouldDrawLineToRight determines if a line should be drawn to the right
@@ -67,13 +67,16 @@ func (vm *MapGridViewModel) ShouldDrawLineToUpper(x, y int) bool {
 // PointViewModel provides display information for individual points
 type PointViewModel struct {
 	gameState *core.GameState
+	x, y      int
 	point     core.Point
 }
 
 // NewPointViewModel creates a new PointViewModel
-func NewPointViewModel(gameState *core.GameState, point core.Point) *PointViewModel {
+func NewPointViewModel(gameState *core.GameState, x, y int, point core.Point) *PointViewModel {
 	return &PointViewModel{
 		gameState: gameState,
+		x:         x,
+		y:         y,
 		point:     point,
 	}
 }
@@ -128,3 +131,40 @@ func (vm *PointViewModel) EnemyPower() float64 {
 	}
 	return 0.0
 }
+
+// IsEnemyRevealed returns whether the enemy skills can be shown
+func (vm *PointViewModel) IsEnemyRevealed() bool {
+	return vm.gameState.MapGrid.IsRevealed(vm.x, vm.y)
+}
+
+// EnemySkillNames returns the localized enemy skill names if present
+func (vm *PointViewModel) EnemySkillNames() []string {
+	battlePoint, ok := vm.point.AsBattlePoint()
+	if !ok || battlePoint.Enemy() == nil {
+		return nil
+	}
+	skills := battlePoint.Enemy().Skills()
+	names := make([]string, len(skills))
+	for i, skill := range skills {
+		names[i] = lang.Text(string(skill.ID()))
+	}
+	return names
+}
+
+// MinimapColor returns the color of the point on the minimap
+func (vm *PointViewModel) MinimapColor() drawing.ColorF32 {
+	switch p := vm.point.(type) {
+	case *core.MyNationPoint:
+		return drawing.NewColorF32(0.3, 0.5, 1, 1)
+	case *core.OtherNationPoint:
+		return drawing.NewColorF32(0.3, 0.8, 0.3, 1)
+	case *core.WildernessPoint:
+		if p.Controlled() {
+			return drawing.NewColorF32(0.6, 0.8, 1, 1)
+		}
+		return drawing.NewColorF32(0.5, 0.5, 0.5, 1)
+	case *core.BossPoint:
+		return drawing.NewColorF32(1, 0.2, 0.2, 1)
+	}
+	return drawing.NewColorF32(0.2, 0.2, 0.2, 1)
+}
@@ -24,9 +24,10 @@ func (vm *ResourceViewModel) Quantity() core.ResourceQuantity {
 	return vm.gameState.Treasury.Resources
 }
 
+// Yield returns the resources gained each turn after paying the upkeep
 func (vm *ResourceViewModel) Yield() core.ResourceQuantity {
 	if vm.gameState == nil {
 		return core.ResourceQuantity{}
 	}
-	return vm.gameState.GetYield()
+	return vm.gameState.GetYield().Sub(vm.gameState.GetUpkeep())
 }
@@ -0,0 +1,58 @@
+package viewmodel
+
+import (
+	"github.com/noppikinatta/ebitenginegamejam2025/core"
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+)
+
+// ResultViewModel provides display information for the end-of-run result screen
+type ResultViewModel struct {
+	gameState *core.GameState
+}
+
+// NewResultViewModel creates a new ResultViewModel
+func NewResultViewModel(gameState *core.GameState) *ResultViewModel {
+	return &ResultViewModel{
+		gameState: gameState,
+	}
+}
+
+// Title returns the result title
+func (vm *ResultViewModel) Title() string {
+	return lang.Text("result-title")
+}
+
+// StatTexts returns the localized statistics of the run
+func (vm *ResultViewModel) StatTexts() []string {
+	if vm.gameState == nil {
+		return []string{}
+	}
+
+	r := vm.gameState.Treasury.Resources
+	difficulty := ""
+	if vm.gameState.Difficulty != nil {
+		difficulty = lang.Text(string(vm.gameState.Difficulty.ID))
+	}
+
+	return []string{
+		lang.ExecuteTemplate("result-difficulty", map[string]any{"difficulty": difficulty}),
+		lang.ExecuteTemplate("result-turns", map[string]any{"turns": int(vm.gameState.CurrentTurn)}),
+		lang.ExecuteTemplate("result-territories", map[string]any{"territories": vm.gameState.ConqueredTerritories()}),
+		lang.ExecuteTemplate("result-packs", map[string]any{"packs": vm.gameState.Stats.PacksOpened}),
+		lang.ExecuteTemplate("result-treasury", map[string]any{
+			"money": r.Money,
+			"food":  r.Food,
+			"wood":  r.Wood,
+			"iron":  r.Iron,
+			"mana":  r.Mana,
+		}),
+	}
+}
+
+// ScoreText returns the localized score of the run
+func (vm *ResultViewModel) ScoreText() string {
+	if vm.gameState == nil {
+		return ""
+	}
+	return lang.ExecuteTemplate("result-score", map[string]any{"score": vm.gameState.Score()})
+}
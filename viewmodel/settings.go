@@ -0,0 +1,98 @@
+package viewmodel
+
+import (
+	"math"
+
+	"github.com/noppikinatta/ebitenginegamejam2025/lang"
+	"github.com/noppikinatta/ebitenginegamejam2025/store"
+)
+
+// SettingsRow is an item of the settings screen.
+type SettingsRow int
+
+const (
+	SettingsRowLanguage SettingsRow = iota
+	SettingsRowMasterVolume
+	SettingsRowBGMVolume
+	SettingsRowSEVolume
+	SettingsRowWindowSize
+	SettingsRowFullscreen
+	SettingsRowDifficulty
+	NumSettingsRows int = iota
+)
+
+// SettingsViewModel provides display information for the settings screen
+type SettingsViewModel struct {
+	settings *store.Settings
+}
+
+// NewSettingsViewModel creates a new SettingsViewModel
+func NewSettingsViewModel(settings *store.Settings) *SettingsViewModel {
+	return &SettingsViewModel{
+		settings: settings,
+	}
+}
+
+// Title returns the settings screen title
+func (vm *SettingsViewModel) Title() string {
+	return lang.Text("settings-title")
+}
+
+// Label returns the localized name of the row
+func (vm *SettingsViewModel) Label(row SettingsRow) string {
+	switch row {
+	case SettingsRowLanguage:
+		return lang.Text("settings-language")
+	case SettingsRowMasterVolume:
+		return lang.Text("settings-master-volume")
+	case SettingsRowBGMVolume:
+		return lang.Text("settings-bgm-volume")
+	case SettingsRowSEVolume:
+		return lang.Text("settings-se-volume")
+	case SettingsRowWindowSize:
+		return lang.Text("settings-window-size")
+	case SettingsRowFullscreen:
+		return lang.Text("settings-fullscreen")
+	case SettingsRowDifficulty:
+		return lang.Text("settings-difficulty")
+	default:
+		return ""
+	}
+}
+
+// Value returns the localized current value of the row
+func (vm *SettingsViewModel) Value(row SettingsRow) string {
+	switch row {
+	case SettingsRowLanguage:
+		return lang.DisplayName(vm.settings.Language)
+	case SettingsRowMasterVolume:
+		return volumeText(vm.settings.MasterVolume)
+	case SettingsRowBGMVolume:
+		return volumeText(vm.settings.BGMVolume)
+	case SettingsRowSEVolume:
+		return volumeText(vm.settings.SEVolume)
+	case SettingsRowWindowSize:
+		return lang.ExecuteTemplate("settings-window-size-value", map[string]any{
+			"width":  vm.settings.WindowSize.Width,
+			"height": vm.settings.WindowSize.Height,
+		})
+	case SettingsRowFullscreen:
+		if vm.settings.Fullscreen {
+			return lang.Text("settings-on")
+		}
+		return lang.Text("settings-off")
+	case SettingsRowDifficulty:
+		return lang.Text(vm.settings.Difficulty)
+	default:
+		return ""
+	}
+}
+
+func volumeText(volume float64) string {
+	return lang.ExecuteTemplate("settings-volume-value", map[string]any{"percent": int(math.Round(volume * 100))})
+}
+
+// BackText returns the text of the button saving the settings
+func (vm *SettingsViewModel) BackText() string {
+	return lang.Text("settings-back")
+}
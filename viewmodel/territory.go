@@ -7,14 +7,19 @@ import (
 
 // TerritoryViewModel provides display information for territory UI
 type TerritoryViewModel struct {
+	gameState          *core.GameState
+	x, y               int
 	territory          *core.Territory
 	constructionPlan   *core.ConstructionPlan
 	cardViewModelCache *CardViewModel
 }
 
 // NewTerritoryViewModel creates a new TerritoryViewModel
-func NewTerritoryViewModel(territory *core.Territory, constructionPlan *core.ConstructionPlan) *TerritoryViewModel {
+func NewTerritoryViewModel(gameState *core.GameState, x, y int, territory *core.Territory, constructionPlan *core.ConstructionPlan) *TerritoryViewModel {
 	return &TerritoryViewModel{
+		gameState:        gameState,
+		x:                x,
+		y:                y,
 		territory:        territory,
 		constructionPlan: constructionPlan,
 	}
@@ -26,9 +31,62 @@ func (vm *TerritoryViewModel) Title() string {
 	return lang.Text(string(vm.territory.Terrain().ID()))
 }
 
+// LevelText returns the localized development level of the territory
+func (vm *TerritoryViewModel) LevelText() string {
+	return lang.ExecuteTemplate("territory-level", map[string]any{
+		"level": vm.territory.Level(),
+		"turns": int(vm.territory.HeldTurns()),
+	})
+}
+
+// NextLevelCost returns the cost of the next level, or false if the territory is at the max level
+func (vm *TerritoryViewModel) NextLevelCost() (core.ResourceQuantity, bool) {
+	next, ok := vm.territory.NextLevel()
+	if !ok {
+		return core.ResourceQuantity{}, false
+	}
+	return next.Cost, true
+}
+
+// CanLevelUp returns whether the treasury can pay the next level
+func (vm *TerritoryViewModel) CanLevelUp() bool {
+	cost, ok := vm.NextLevelCost()
+	return ok && vm.gameState.Treasury.Resources.CanPurchase(cost)
+}
+
+// TerrainUpgradeText returns the localized name of the upgraded terrain, or false if no upgrade is available
+func (vm *TerritoryViewModel) TerrainUpgradeText() (string, bool) {
+	upgrade, ok := vm.territory.TerrainUpgrade()
+	if !ok {
+		return "", false
+	}
+	return lang.ExecuteTemplate("territory-upgrade", map[string]any{
+		"terrain": lang.Text(string(upgrade.To.ID())),
+		"level":   upgrade.MinLevel,
+	}), true
+}
+
+// TerrainUpgradeCost returns the cost of the terrain upgrade
+func (vm *TerritoryViewModel) TerrainUpgradeCost() core.ResourceQuantity {
+	upgrade, ok := vm.territory.TerrainUpgrade()
+	if !ok {
+		return core.ResourceQuantity{}
+	}
+	return upgrade.Cost
+}
+
+// CanUpgradeTerrain returns whether the level and the treasury allow the terrain upgrade
+func (vm *TerritoryViewModel) CanUpgradeTerrain() bool {
+	upgrade, ok := vm.territory.TerrainUpgrade()
+	if !ok {
+		return false
+	}
+	return vm.territory.Level() >= upgrade.MinLevel && vm.gameState.Treasury.Resources.CanPurchase(upgrade.Cost)
+}
+
 // CardSlot returns the maximum number of cards that can be placed
 func (vm *TerritoryViewModel) CardSlot() int {
-	return vm.territory.Terrain().CardSlot()
+	return vm.territory.CardSlot()
 }
 
 // NumCards returns the current number of cards in the territory
@@ -51,14 +109,23 @@ func (vm *TerritoryViewModel) Card(idx int) (*CardViewModel, bool) {
 	return vm.cardViewModelCache, true
 }
 
-// Yield returns the total yield of the territory including card effects
+// Yield returns the total yield of the territory including card effects and synergies with the neighbours
 func (vm *TerritoryViewModel) CurrentYield() core.ResourceQuantity {
-	return vm.territory.Yield()
+	yield := vm.territory.Yield()
+	if vm.gameState != nil {
+		yield = yield.Add(vm.gameState.MapGrid.SynergyBonus(vm.x, vm.y))
+	}
+	return yield
 }
 
-// PredictedYield returns the predicted yield of the territory including card effects
+// PredictedYield returns the predicted yield of the territory including card effects.
+// It also includes the synergy changes the plan causes in the neighbouring territories.
 func (vm *TerritoryViewModel) PredictedYield() core.ResourceQuantity {
-	return vm.constructionPlan.Yield()
+	yield := vm.constructionPlan.Yield()
+	if vm.gameState != nil {
+		yield = yield.Add(vm.gameState.MapGrid.PredictedSynergyBonus(vm.x, vm.y, vm.constructionPlan.Cards()))
+	}
+	return yield
 }
 
 // SupportPower returns the total support power provided by structure cards